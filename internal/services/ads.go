@@ -0,0 +1,69 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// StartCommercial runs a commercial of length seconds on the broadcaster's
+// live stream. Twitch caps the length at 180 seconds.
+func StartCommercial(client *http.Client, accessToken, broadcasterID string, length int) (*Commercial, error) {
+	payload := map[string]any{
+		"broadcaster_id": broadcasterID,
+		"length":         length,
+	}
+
+	status, body, err := doHelixRequest(client, "POST", twitchCommercialURL, accessToken, payload)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	commercials, err := decodeData[Commercial](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(commercials) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no commercial returned")
+	}
+	return &commercials[0], nil
+}
+
+// GetAdSchedule retrieves when the broadcaster's next ad is due and how
+// many snoozes are left.
+func GetAdSchedule(client *http.Client, accessToken, broadcasterID string) (*AdSchedule, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	return adScheduleRequest(client, "GET", twitchAdScheduleURL+"?"+q.Encode(), accessToken)
+}
+
+// SnoozeNextAd pushes the broadcaster's next scheduled ad back by five
+// minutes, using up one snooze. Only the snooze and next ad fields of the
+// returned schedule are set.
+func SnoozeNextAd(client *http.Client, accessToken, broadcasterID string) (*AdSchedule, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	return adScheduleRequest(client, "POST", twitchSnoozeAdURL+"?"+q.Encode(), accessToken)
+}
+
+func adScheduleRequest(client *http.Client, method, endpoint, accessToken string) (*AdSchedule, error) {
+	status, body, err := doHelixRequest(client, method, endpoint, accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	schedules, err := decodeData[AdSchedule](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(schedules) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no ad schedule returned")
+	}
+	return &schedules[0], nil
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStartCommercial(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"length": 60, "message": "", "retry_after": 480}]}`,
+		},
+		{
+			name:        "400 Bad Request - not live",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"Bad Request","status":400,"message":"To start a commercial, the broadcaster must be streaming live."}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				body := decodeRequestBody(req)
+				return req.Method == "POST" && body["broadcaster_id"] == "41245072" && body["length"] == float64(60)
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			commercial, err := StartCommercial(client, "token", "41245072", 60)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 480, commercial.RetryAfter)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetAdSchedule(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "GET" && req.URL.Query().Get("broadcaster_id") == "123"
+	})).Return(makeResp(http.StatusOK, `{"data": [{"next_ad_at": "2023-08-01T23:08:18+00:00", "last_ad_at": "2023-08-01T23:08:18+00:00", "duration": 60, "preroll_free_time": 90, "snooze_count": 1, "snooze_refresh_at": "2023-08-01T23:08:18+00:00"}]}`), nil)
+	client := buildMockClient(mockRT)
+
+	schedule, err := GetAdSchedule(client, "token", "123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-08-01T23:08:18+00:00", schedule.NextAdAt)
+	assert.Equal(t, 1, schedule.SnoozeCount)
+	mockRT.AssertExpectations(t)
+}
+
+func TestSnoozeNextAd(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"snooze_count": 1, "snooze_refresh_at": "2023-08-01T23:08:18+00:00", "next_ad_at": "2023-08-01T23:08:18+00:00"}]}`,
+		},
+		{
+			name:        "429 Too Many Requests - no snoozes left",
+			respCode:    http.StatusTooManyRequests,
+			respBody:    `{"error":"Too Many Requests","status":429,"message":"The broadcaster has no snoozes left."}`,
+			wantErr:     true,
+			errContains: "429",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "POST" && req.URL.Path == "/helix/channels/ads/schedule/snooze"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			schedule, err := SnoozeNextAd(client, "token", "123")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, schedule.SnoozeCount)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
@@ -13,6 +13,42 @@ const clientID = "8pbsu0inj1huddl1inp1800p4vtmwy"
 const twitchChatMessagesURL = "https://api.twitch.tv/helix/chat/messages"
 const twitchUsersURL = "https://api.twitch.tv/helix/users"
 const twitchEventSubSubscriptionsURL = "https://api.twitch.tv/helix/eventsub/subscriptions"
+const twitchChannelFollowersURL = "https://api.twitch.tv/helix/channels/followers"
+const twitchSubscriptionsURL = "https://api.twitch.tv/helix/subscriptions"
+const twitchBansURL = "https://api.twitch.tv/helix/moderation/bans"
+const twitchModerationChatURL = "https://api.twitch.tv/helix/moderation/chat"
+const twitchChatSettingsURL = "https://api.twitch.tv/helix/chat/settings"
+const twitchChatAnnouncementsURL = "https://api.twitch.tv/helix/chat/announcements"
+const twitchChatShoutoutsURL = "https://api.twitch.tv/helix/chat/shoutouts"
+const twitchChatColorURL = "https://api.twitch.tv/helix/chat/color"
+const twitchRaidsURL = "https://api.twitch.tv/helix/raids"
+const twitchWhispersURL = "https://api.twitch.tv/helix/whispers"
+const twitchStreamsURL = "https://api.twitch.tv/helix/streams"
+const twitchChannelsURL = "https://api.twitch.tv/helix/channels"
+const twitchSearchCategoriesURL = "https://api.twitch.tv/helix/search/categories"
+const twitchGlobalEmotesURL = "https://api.twitch.tv/helix/chat/emotes/global"
+const twitchChannelEmotesURL = "https://api.twitch.tv/helix/chat/emotes"
+const twitchUserEmotesURL = "https://api.twitch.tv/helix/chat/emotes/user"
+const twitchGlobalBadgesURL = "https://api.twitch.tv/helix/chat/badges/global"
+const twitchChannelBadgesURL = "https://api.twitch.tv/helix/chat/badges"
+const twitchBitsLeaderboardURL = "https://api.twitch.tv/helix/bits/leaderboard"
+const twitchCustomRewardsURL = "https://api.twitch.tv/helix/channel_points/custom_rewards"
+const twitchRedemptionsURL = "https://api.twitch.tv/helix/channel_points/custom_rewards/redemptions"
+const twitchPollsURL = "https://api.twitch.tv/helix/polls"
+const twitchPredictionsURL = "https://api.twitch.tv/helix/predictions"
+const twitchCommercialURL = "https://api.twitch.tv/helix/channels/commercial"
+const twitchAdScheduleURL = "https://api.twitch.tv/helix/channels/ads"
+const twitchSnoozeAdURL = "https://api.twitch.tv/helix/channels/ads/schedule/snooze"
+const twitchAutoModMessageURL = "https://api.twitch.tv/helix/moderation/automod/message"
+const twitchAutoModSettingsURL = "https://api.twitch.tv/helix/moderation/automod/settings"
+const twitchBlockedTermsURL = "https://api.twitch.tv/helix/moderation/blocked_terms"
+const twitchUnbanRequestsURL = "https://api.twitch.tv/helix/moderation/unban_requests"
+const twitchShieldModeURL = "https://api.twitch.tv/helix/moderation/shield_mode"
+const twitchWarningsURL = "https://api.twitch.tv/helix/moderation/warnings"
+const twitchModeratorsURL = "https://api.twitch.tv/helix/moderation/moderators"
+const twitchVIPsURL = "https://api.twitch.tv/helix/channels/vips"
+const twitchClipsURL = "https://api.twitch.tv/helix/clips"
+const twitchStreamMarkersURL = "https://api.twitch.tv/helix/streams/markers"
 
 // SendMessage sends a chat message to Twitch using the API.
 func SendMessage(client *http.Client, accessToken, senderId, message string) error {
@@ -22,6 +58,21 @@ func SendMessage(client *http.Client, accessToken, senderId, message string) err
 		"sender_id":      senderId,
 		"message":        message,
 	}
+	return sendChatMessage(client, accessToken, payload)
+}
+
+// SendReply sends a chat message as a reply to the message with parentMessageID.
+func SendReply(client *http.Client, accessToken, senderId, parentMessageID, message string) error {
+	payload := map[string]any{
+		"broadcaster_id":          senderId,
+		"sender_id":               senderId,
+		"message":                 message,
+		"reply_parent_message_id": parentMessageID,
+	}
+	return sendChatMessage(client, accessToken, payload)
+}
+
+func sendChatMessage(client *http.Client, accessToken string, payload map[string]any) error {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal request body: %w", err)
@@ -128,6 +179,23 @@ func GetUsers(httpClient *http.Client, accessToken string, userIDs ...string) ([
 	return nil, fmt.Errorf("twitch API: unexpected status %d: %s", resp.StatusCode, string(body))
 }
 
+// GetUsersByLogin retrieves info about one or more users by their login names.
+func GetUsersByLogin(httpClient *http.Client, accessToken string, logins ...string) ([]UserInfo, error) {
+	q := url.Values{}
+	for _, login := range logins {
+		q.Add("login", login)
+	}
+
+	status, body, err := doHelixRequest(httpClient, "GET", twitchUsersURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+	return decodeData[UserInfo](body)
+}
+
 // CreateEventSub creates a new EventSub subscription via Twitch API.
 func CreateEventSub(client *http.Client, accessToken, sessionID string, subscriptionMsg map[string]any) error {
 	body, err := json.Marshal(subscriptionMsg)
@@ -171,3 +239,85 @@ func CreateEventSub(client *http.Client, accessToken, sessionID string, subscrip
 	}
 	return fmt.Errorf("twitch API: unexpected status %d: %s", resp.StatusCode, string(body))
 }
+
+// doHelixRequest executes an authenticated request against the Twitch API.
+// A non-nil payload is encoded as the JSON request body. It returns the
+// response status code and the raw response body.
+func doHelixRequest(client *http.Client, method, endpoint, accessToken string, payload any) (int, []byte, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = bytes.NewBuffer(body)
+	}
+
+	req, err := http.NewRequest(method, endpoint, reqBody)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Client-Id", clientID)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return resp.StatusCode, body, nil
+}
+
+// helixStatusError describes an unsuccessful Twitch API response.
+func helixStatusError(statusCode int, body []byte) error {
+	switch statusCode {
+	case http.StatusBadRequest: // 400
+		return fmt.Errorf("twitch API: bad request (400): %s", string(body))
+	case http.StatusUnauthorized: // 401
+		return fmt.Errorf("twitch API: unauthorized (401): %s", string(body))
+	case http.StatusForbidden: // 403
+		return fmt.Errorf("twitch API: forbidden (403): %s", string(body))
+	case http.StatusNotFound: // 404
+		return fmt.Errorf("twitch API: not found (404): %s", string(body))
+	case http.StatusConflict: // 409
+		return fmt.Errorf("twitch API: conflict (409): %s", string(body))
+	case http.StatusTooManyRequests: // 429
+		return fmt.Errorf("twitch API: too many requests (429): %s", string(body))
+	}
+	return fmt.Errorf("twitch API: unexpected status %d: %s", statusCode, string(body))
+}
+
+// decodeData unmarshals the "data" array of a Twitch API response.
+func decodeData[T any](body []byte) ([]T, error) {
+	var out struct {
+		Data []T `json:"data"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return out.Data, nil
+}
+
+// decodePage unmarshals the "data" array of a paginated Twitch API
+// response along with the cursor of the next page, empty on the last page.
+func decodePage[T any](body []byte) ([]T, string, error) {
+	var out struct {
+		Data       []T `json:"data"`
+		Pagination struct {
+			Cursor string `json:"cursor"`
+		} `json:"pagination"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, "", fmt.Errorf("failed to decode response: %w", err)
+	}
+	return out.Data, out.Pagination.Cursor, nil
+}
@@ -151,3 +151,18 @@ func TestCreateEventSub(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUsersByLogin(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Query().Get("login") == "twitchdev"
+	})).Return(makeResp(http.StatusOK, `{"data": [{"id": "141981764", "login": "twitchdev", "display_name": "TwitchDev"}]}`), nil)
+	client := buildMockClient(mockRT)
+
+	users, err := GetUsersByLogin(client, "token", "twitchdev")
+
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, "141981764", users[0].ID)
+	mockRT.AssertExpectations(t)
+}
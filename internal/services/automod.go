@@ -0,0 +1,61 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ManageHeldAutoModMessage allows or denies a message AutoMod held for
+// review. action is ALLOW or DENY.
+func ManageHeldAutoModMessage(client *http.Client, accessToken, moderatorID, messageID, action string) error {
+	payload := map[string]any{
+		"user_id": moderatorID,
+		"msg_id":  messageID,
+		"action":  action,
+	}
+
+	status, body, err := doHelixRequest(client, "POST", twitchAutoModMessageURL, accessToken, payload)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNoContent {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
+
+// GetAutoModSettings retrieves the broadcaster's AutoMod levels.
+func GetAutoModSettings(client *http.Client, accessToken, broadcasterID, moderatorID string) (*AutoModSettings, error) {
+	return autoModSettingsRequest(client, "GET", accessToken, broadcasterID, moderatorID, nil)
+}
+
+// UpdateAutoModSettings replaces the broadcaster's AutoMod levels. settings
+// holds either overall_level or every individual level, as Twitch resets
+// any level left out.
+func UpdateAutoModSettings(client *http.Client, accessToken, broadcasterID, moderatorID string, settings map[string]any) (*AutoModSettings, error) {
+	return autoModSettingsRequest(client, "PUT", accessToken, broadcasterID, moderatorID, settings)
+}
+
+func autoModSettingsRequest(client *http.Client, method, accessToken, broadcasterID, moderatorID string, payload any) (*AutoModSettings, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+
+	status, body, err := doHelixRequest(client, method, twitchAutoModSettingsURL+"?"+q.Encode(), accessToken, payload)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	settings, err := decodeData[AutoModSettings](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(settings) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no AutoMod settings returned")
+	}
+	return &settings[0], nil
+}
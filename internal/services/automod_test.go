@@ -0,0 +1,110 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestManageHeldAutoModMessage(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "204 No Content",
+			respCode: http.StatusNoContent,
+		},
+		{
+			name:        "404 Not Found - message no longer held",
+			respCode:    http.StatusNotFound,
+			respBody:    `{"error":"Not Found","status":404,"message":"The message is not held or has expired."}`,
+			wantErr:     true,
+			errContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				body := decodeRequestBody(req)
+				return req.Method == "POST" && body["user_id"] == "9327994" && body["msg_id"] == "836013710" && body["action"] == "ALLOW"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			err := ManageHeldAutoModMessage(client, "token", "9327994", "836013710", "ALLOW")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetAutoModSettings(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "GET" && req.URL.Query().Get("moderator_id") == "1234"
+	})).Return(makeResp(http.StatusOK, `{"data": [{"broadcaster_id": "1234", "moderator_id": "1234", "overall_level": null, "disability": 0, "aggression": 0, "sexuality_sex_or_gender": 0, "misogyny": 0, "bullying": 0, "swearing": 0, "race_ethnicity_or_religion": 0, "sex_based_terms": 0}]}`), nil)
+	client := buildMockClient(mockRT)
+
+	settings, err := GetAutoModSettings(client, "token", "1234", "1234")
+
+	assert.NoError(t, err)
+	assert.Nil(t, settings.OverallLevel)
+	mockRT.AssertExpectations(t)
+}
+
+func TestUpdateAutoModSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"broadcaster_id": "1234", "moderator_id": "1234", "overall_level": 3, "disability": 3, "aggression": 3, "sexuality_sex_or_gender": 3, "misogyny": 3, "bullying": 2, "swearing": 0, "race_ethnicity_or_religion": 3, "sex_based_terms": 3}]}`,
+		},
+		{
+			name:        "400 Bad Request - level out of range",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"Bad Request","status":400,"message":"The overall level must be between 0 and 4."}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "PUT" && decodeRequestBody(req)["overall_level"] == float64(3)
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			settings, err := UpdateAutoModSettings(client, "token", "1234", "1234", map[string]any{"overall_level": 3})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 3, *settings.OverallLevel)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
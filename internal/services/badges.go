@@ -0,0 +1,30 @@
+package services
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// GetGlobalChatBadges lists the chat badges shared by every channel.
+func GetGlobalChatBadges(client *http.Client, accessToken string) ([]BadgeSet, error) {
+	return getChatBadges(client, twitchGlobalBadgesURL, accessToken)
+}
+
+// GetChannelChatBadges lists the broadcaster's own chat badges, such as
+// their subscriber and Bits badges.
+func GetChannelChatBadges(client *http.Client, accessToken, broadcasterID string) ([]BadgeSet, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	return getChatBadges(client, twitchChannelBadgesURL+"?"+q.Encode(), accessToken)
+}
+
+func getChatBadges(client *http.Client, endpoint, accessToken string) ([]BadgeSet, error) {
+	status, body, err := doHelixRequest(client, "GET", endpoint, accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+	return decodeData[BadgeSet](body)
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetGlobalChatBadges(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantSets    int
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"set_id": "vip", "versions": [{"id": "1", "image_url_1x": "https://static-cdn.jtvnw.net/badges/v1/b817aba4-fad8-49e2-b88a-7cc744dfa6ec/1", "title": "VIP", "description": "VIP", "click_action": "visit_url", "click_url": "https://help.twitch.tv/customer/en/portal/articles/659115-twitch-chat-badges-guide"}]}]}`,
+			wantSets: 1,
+		},
+		{
+			name:        "401 Unauthorized",
+			respCode:    http.StatusUnauthorized,
+			respBody:    `{"error":"Unauthorized","status":401,"message":"Invalid OAuth token"}`,
+			wantErr:     true,
+			errContains: "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "GET" && req.URL.Path == "/helix/chat/badges/global"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			sets, err := GetGlobalChatBadges(client, "token")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, sets, tt.wantSets)
+				assert.Equal(t, "vip", sets[0].SetID)
+				assert.Equal(t, "VIP", sets[0].Versions[0].Title)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetChannelChatBadges(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "GET" && req.URL.Path == "/helix/chat/badges" && req.URL.Query().Get("broadcaster_id") == "135093069"
+	})).Return(makeResp(http.StatusOK, `{"data": [{"set_id": "bits", "versions": [{"id": "1", "image_url_1x": "https://static-cdn.jtvnw.net/badges/v1/743a0f3b-84b3-450b-96a0-503d7f4a9764/1", "title": "cheer 1", "description": "cheer 1", "click_action": null, "click_url": null}]}, {"set_id": "subscriber", "versions": [{"id": "0", "image_url_1x": "https://static-cdn.jtvnw.net/badges/v1/eb4a8a4c-eacd-4f5e-b9f2-394348310442/1", "title": "Subscriber", "description": "Subscriber", "click_action": "subscribe_to_channel", "click_url": null}]}]}`), nil)
+	client := buildMockClient(mockRT)
+
+	sets, err := GetChannelChatBadges(client, "token", "135093069")
+
+	assert.NoError(t, err)
+	assert.Len(t, sets, 2)
+	assert.Equal(t, "subscriber", sets[1].SetID)
+	assert.Equal(t, "subscribe_to_channel", sets[1].Versions[0].ClickAction)
+	mockRT.AssertExpectations(t)
+}
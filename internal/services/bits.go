@@ -0,0 +1,31 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// GetBitsLeaderboard retrieves the top cheerers in the broadcaster's channel
+// over a period of day, week, month, year or all.
+func GetBitsLeaderboard(client *http.Client, accessToken string, count int, period string) (*BitsLeaderboard, error) {
+	q := url.Values{}
+	q.Set("count", strconv.Itoa(count))
+	q.Set("period", period)
+
+	status, body, err := doHelixRequest(client, "GET", twitchBitsLeaderboardURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	var leaderboard BitsLeaderboard
+	if err := json.Unmarshal(body, &leaderboard); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &leaderboard, nil
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetBitsLeaderboard(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantEntries int
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: `{"data": [
+				{"user_id": "158010205", "user_login": "tundracowboy", "user_name": "TundraCowboy", "rank": 1, "score": 12543},
+				{"user_id": "7168163", "user_login": "topramens", "user_name": "Topramens", "rank": 2, "score": 6900}
+			], "date_range": {"started_at": "2018-02-05T08:00:00Z", "ended_at": "2018-02-12T08:00:00Z"}, "total": 2}`,
+			wantEntries: 2,
+		},
+		{
+			name:        "400 Bad Request - invalid period",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"The value in the period query parameter is not valid."}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+		{
+			name:        "403 Forbidden - not the broadcaster",
+			respCode:    http.StatusForbidden,
+			respBody:    `{"error":"Missing bits:read scope"}`,
+			wantErr:     true,
+			errContains: "forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.URL.Query().Get("period") == "week" && req.URL.Query().Get("count") == "10"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			leaderboard, err := GetBitsLeaderboard(client, "token", 10, "week")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, leaderboard.Data, tt.wantEntries)
+				assert.Equal(t, "TundraCowboy", leaderboard.Data[0].UserName)
+				assert.Equal(t, 12543, leaderboard.Data[0].Score)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetBlockedTerms lists a page of up to 100 of the broadcaster's blocked
+// terms, starting after the cursor of the previous page. The returned
+// cursor is empty on the last page.
+func GetBlockedTerms(client *http.Client, accessToken, broadcasterID, moderatorID, after string) ([]BlockedTerm, string, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+	q.Set("first", "100")
+	if after != "" {
+		q.Set("after", after)
+	}
+
+	status, body, err := doHelixRequest(client, "GET", twitchBlockedTermsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if status != http.StatusOK {
+		return nil, "", helixStatusError(status, body)
+	}
+	return decodePage[BlockedTerm](body)
+}
+
+// AddBlockedTerm blocks a word or phrase, 2 to 500 characters long, in the
+// broadcaster's chat. Adding a term that is already blocked returns it.
+func AddBlockedTerm(client *http.Client, accessToken, broadcasterID, moderatorID, text string) (*BlockedTerm, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+
+	status, body, err := doHelixRequest(client, "POST", twitchBlockedTermsURL+"?"+q.Encode(), accessToken, map[string]any{"text": text})
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	terms, err := decodeData[BlockedTerm](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no blocked term returned")
+	}
+	return &terms[0], nil
+}
+
+// RemoveBlockedTerm unblocks the blocked term with the given ID.
+func RemoveBlockedTerm(client *http.Client, accessToken, broadcasterID, moderatorID, termID string) error {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+	q.Set("id", termID)
+
+	status, body, err := doHelixRequest(client, "DELETE", twitchBlockedTermsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNoContent {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
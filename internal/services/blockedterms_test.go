@@ -0,0 +1,106 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetBlockedTerms(t *testing.T) {
+	tests := []struct {
+		name       string
+		after      string
+		respBody   string
+		wantTerms  int
+		wantCursor string
+	}{
+		{
+			name:       "first page",
+			respBody:   `{"data": [{"broadcaster_id": "1234", "moderator_id": "5678", "id": "520e4d4e-0cda-49c7-821e-e5ef4f88c2f2", "text": "A phrase I'm not fond of", "created_at": "2021-09-29T19:45:37Z", "updated_at": "2021-09-29T19:45:37Z", "expires_at": null}], "pagination": {"cursor": "eyJiIjpudWxsLCJhIjp7IkN1cnNvciI6I"}}`,
+			wantTerms:  1,
+			wantCursor: "eyJiIjpudWxsLCJhIjp7IkN1cnNvciI6I",
+		},
+		{
+			name:      "last page",
+			after:     "eyJiIjpudWxsLCJhIjp7IkN1cnNvciI6I",
+			respBody:  `{"data": [], "pagination": {}}`,
+			wantTerms: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "GET" && req.URL.Query().Get("after") == tt.after
+			})).Return(makeResp(http.StatusOK, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			terms, cursor, err := GetBlockedTerms(client, "token", "1234", "5678", tt.after)
+
+			assert.NoError(t, err)
+			assert.Len(t, terms, tt.wantTerms)
+			assert.Equal(t, tt.wantCursor, cursor)
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAddBlockedTerm(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"broadcaster_id": "1234", "moderator_id": "5678", "id": "da27b4b8-3d9d-4c6f-9de7-4e2e8e1f1d0e", "text": "A phrase I'm not fond of", "created_at": "2021-09-29T19:45:37Z", "updated_at": "2021-09-29T19:45:37Z", "expires_at": null}]}`,
+		},
+		{
+			name:        "400 Bad Request - term too short",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"Bad Request","status":400,"message":"The text must be between 2 and 500 characters."}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "POST" && decodeRequestBody(req)["text"] == "A phrase I'm not fond of"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			term, err := AddBlockedTerm(client, "token", "1234", "5678", "A phrase I'm not fond of")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "da27b4b8-3d9d-4c6f-9de7-4e2e8e1f1d0e", term.ID)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRemoveBlockedTerm(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "DELETE" && req.URL.Query().Get("id") == "c9fc79b8-0f63-4ef7-9d38-efd811e74ac2"
+	})).Return(makeResp(http.StatusNoContent, ""), nil)
+	client := buildMockClient(mockRT)
+
+	err := RemoveBlockedTerm(client, "token", "1234", "5678", "c9fc79b8-0f63-4ef7-9d38-efd811e74ac2")
+
+	assert.NoError(t, err)
+	mockRT.AssertExpectations(t)
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// GetChannelFollower reports whether userID follows the broadcaster's channel.
+// It returns nil without an error when the user does not follow the channel.
+func GetChannelFollower(client *http.Client, accessToken, broadcasterID, userID string) (*Follower, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("user_id", userID)
+
+	status, body, err := doHelixRequest(client, "GET", twitchChannelFollowersURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	followers, err := decodeData[Follower](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(followers) == 0 {
+		return nil, nil
+	}
+	return &followers[0], nil
+}
+
+// GetBroadcasterSubscription reports whether userID subscribes to the broadcaster's channel.
+// It returns nil without an error when the user is not subscribed.
+func GetBroadcasterSubscription(client *http.Client, accessToken, broadcasterID, userID string) (*Subscription, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("user_id", userID)
+
+	status, body, err := doHelixRequest(client, "GET", twitchSubscriptionsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	subs, err := decodeData[Subscription](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(subs) == 0 {
+		return nil, nil
+	}
+	return &subs[0], nil
+}
+
+// StartRaid raids toBroadcasterID from fromBroadcasterID's channel.
+// The raid begins once the broadcaster confirms it or after 90 seconds.
+func StartRaid(client *http.Client, accessToken, fromBroadcasterID, toBroadcasterID string) (*Raid, error) {
+	q := url.Values{}
+	q.Set("from_broadcaster_id", fromBroadcasterID)
+	q.Set("to_broadcaster_id", toBroadcasterID)
+
+	status, body, err := doHelixRequest(client, "POST", twitchRaidsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	raids, err := decodeData[Raid](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(raids) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no raid returned")
+	}
+	return &raids[0], nil
+}
+
+// CancelRaid cancels the broadcaster's pending raid.
+func CancelRaid(client *http.Client, accessToken, broadcasterID string) error {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+
+	status, body, err := doHelixRequest(client, "DELETE", twitchRaidsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNoContent {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
+
+// GetStream retrieves the broadcaster's stream. It returns nil without an
+// error when the broadcaster is not live.
+func GetStream(client *http.Client, accessToken, broadcasterID string) (*Stream, error) {
+	q := url.Values{}
+	q.Set("user_id", broadcasterID)
+
+	status, body, err := doHelixRequest(client, "GET", twitchStreamsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	streams, err := decodeData[Stream](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(streams) == 0 {
+		return nil, nil
+	}
+	return &streams[0], nil
+}
+
+// GetChannelInformation retrieves the broadcaster's channel title, category and tags.
+func GetChannelInformation(client *http.Client, accessToken, broadcasterID string) (*ChannelInfo, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+
+	status, body, err := doHelixRequest(client, "GET", twitchChannelsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	channels, err := decodeData[ChannelInfo](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(channels) == 0 {
+		return nil, fmt.Errorf("twitch API: channel %s not found", broadcasterID)
+	}
+	return &channels[0], nil
+}
+
+// ModifyChannelInformation changes the broadcaster's channel information.
+// Only the fields present in the map are changed, using the Twitch API
+// field names, e.g. "title", "game_id", "broadcaster_language" and "tags".
+func ModifyChannelInformation(client *http.Client, accessToken, broadcasterID string, changes map[string]any) error {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+
+	status, body, err := doHelixRequest(client, "PATCH", twitchChannelsURL+"?"+q.Encode(), accessToken, changes)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNoContent {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
+
+// SearchCategories finds up to limit games and categories whose names
+// match the query.
+func SearchCategories(client *http.Client, accessToken, query string, limit int) ([]Category, error) {
+	q := url.Values{}
+	q.Set("query", query)
+	q.Set("first", strconv.Itoa(limit))
+
+	status, body, err := doHelixRequest(client, "GET", twitchSearchCategoriesURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+	return decodeData[Category](body)
+}
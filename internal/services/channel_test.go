@@ -0,0 +1,288 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetChannelFollower(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantLogin   string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:      "200 OK - following",
+			respCode:  http.StatusOK,
+			respBody:  `{"total": 8, "data": [{"user_id": "11111", "user_login": "userloginname", "user_name": "UserDisplayName", "followed_at": "2022-05-24T22:22:08Z"}], "pagination": {}}`,
+			wantLogin: "userloginname",
+		},
+		{
+			name:     "200 OK - not following",
+			respCode: http.StatusOK,
+			respBody: `{"total": 8, "data": [], "pagination": {}}`,
+		},
+		{
+			name:        "401 Unauthorized - missing scope",
+			respCode:    http.StatusUnauthorized,
+			respBody:    `{"error":"Missing scope: moderator:read:followers"}`,
+			wantErr:     true,
+			errContains: "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.URL.Query().Get("broadcaster_id") == "123" && req.URL.Query().Get("user_id") == "11111"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			follower, err := GetChannelFollower(client, "token", "123", "11111")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				if tt.wantLogin == "" {
+					assert.Nil(t, follower)
+				} else {
+					assert.Equal(t, tt.wantLogin, follower.UserLogin)
+				}
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetBroadcasterSubscription(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.Anything).Return(makeResp(http.StatusOK, `{"data": [{"broadcaster_id": "123", "gifter_name": "Gifter", "is_gift": true, "tier": "1000", "user_id": "11111"}], "total": 1}`), nil)
+	client := buildMockClient(mockRT)
+
+	sub, err := GetBroadcasterSubscription(client, "token", "123", "11111")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1000", sub.Tier)
+	assert.True(t, sub.IsGift)
+	mockRT.AssertExpectations(t)
+}
+
+func TestStartRaid(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"created_at": "2022-02-18T07:20:50.52Z", "is_mature": false}]}`,
+		},
+		{
+			name:        "409 Conflict - already raiding",
+			respCode:    http.StatusConflict,
+			respBody:    `{"error":"The broadcaster is already in the process of raiding another channel."}`,
+			wantErr:     true,
+			errContains: "conflict",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.Anything).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			raid, err := StartRaid(client, "token", "123", "456")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.False(t, raid.IsMature)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCancelRaid(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "204 No Content",
+			respCode: http.StatusNoContent,
+		},
+		{
+			name:        "404 Not Found - no pending raid",
+			respCode:    http.StatusNotFound,
+			respBody:    `{"error":"The broadcaster doesn't have a pending raid to cancel."}`,
+			wantErr:     true,
+			errContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "DELETE" && req.URL.Query().Get("broadcaster_id") == "123"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			err := CancelRaid(client, "token", "123")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetStream(t *testing.T) {
+	tests := []struct {
+		name     string
+		respCode int
+		respBody string
+		wantLive bool
+		wantErr  bool
+	}{
+		{
+			name:     "200 OK - live",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"id": "40952121085", "user_id": "123", "game_name": "Just Chatting", "type": "live", "title": "hello", "viewer_count": 78365, "started_at": "2021-03-10T15:04:21Z"}], "pagination": {}}`,
+			wantLive: true,
+		},
+		{
+			name:     "200 OK - offline",
+			respCode: http.StatusOK,
+			respBody: `{"data": [], "pagination": {}}`,
+		},
+		{
+			name:     "401 Unauthorized",
+			respCode: http.StatusUnauthorized,
+			respBody: `{"error":"Invalid access token"}`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.URL.Query().Get("user_id") == "123"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			stream, err := GetStream(client, "token", "123")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantLive, stream != nil)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetChannelInformation(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Query().Get("broadcaster_id") == "141981764"
+	})).Return(makeResp(http.StatusOK, `{"data": [{"broadcaster_id": "141981764", "broadcaster_login": "twitchdev", "broadcaster_language": "en", "game_id": "509670", "game_name": "Science & Technology", "title": "TwitchDev Monthly Update", "delay": 0, "tags": ["DevsInTheKnow"]}]}`), nil)
+	client := buildMockClient(mockRT)
+
+	info, err := GetChannelInformation(client, "token", "141981764")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Science & Technology", info.GameName)
+	assert.Equal(t, []string{"DevsInTheKnow"}, info.Tags)
+	mockRT.AssertExpectations(t)
+}
+
+func TestModifyChannelInformation(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "204 No Content",
+			respCode: http.StatusNoContent,
+		},
+		{
+			name:        "400 Bad Request - invalid tag",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"Bad Request","status":400,"message":"The tag contains invalid characters."}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				body := decodeRequestBody(req)
+				return req.Method == "PATCH" &&
+					req.URL.Query().Get("broadcaster_id") == "41245072" &&
+					body["title"] == "there are helicopters in the game? REASON TO PLAY FORTNITE found" &&
+					body["game_id"] == "33214" &&
+					len(body) == 2
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			err := ModifyChannelInformation(client, "token", "41245072", map[string]any{
+				"title":   "there are helicopters in the game? REASON TO PLAY FORTNITE found",
+				"game_id": "33214",
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSearchCategories(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "GET" && req.URL.Query().Get("query") == "fort" && req.URL.Query().Get("first") == "10"
+	})).Return(makeResp(http.StatusOK, `{"data": [{"id": "33214", "name": "Fortnite", "box_art_url": "https://static-cdn.jtvnw.net/ttv-boxart/33214-52x72.jpg"}], "pagination": {"cursor": "eyJiIjpudWxsLCJhIjp7IkN"}}`), nil)
+	client := buildMockClient(mockRT)
+
+	categories, err := SearchCategories(client, "token", "fort", 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Category{{ID: "33214", Name: "Fortnite", BoxArtURL: "https://static-cdn.jtvnw.net/ttv-boxart/33214-52x72.jpg"}}, categories)
+	mockRT.AssertExpectations(t)
+}
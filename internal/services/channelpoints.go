@@ -0,0 +1,77 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetCustomRewards lists the broadcaster's channel points rewards. With
+// onlyManageable set, only rewards created by this app are listed, as
+// those are the only ones whose redemptions it may update.
+func GetCustomRewards(client *http.Client, accessToken, broadcasterID string, onlyManageable bool) ([]CustomReward, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	if onlyManageable {
+		q.Set("only_manageable_rewards", "true")
+	}
+
+	status, body, err := doHelixRequest(client, "GET", twitchCustomRewardsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+	return decodeData[CustomReward](body)
+}
+
+// CreateCustomReward creates a channel points reward in the broadcaster's channel.
+func CreateCustomReward(client *http.Client, accessToken, broadcasterID, title string, cost int, prompt string) (*CustomReward, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+
+	payload := map[string]any{
+		"title": title,
+		"cost":  cost,
+	}
+	if prompt != "" {
+		payload["prompt"] = prompt
+		payload["is_user_input_required"] = true
+	}
+
+	status, body, err := doHelixRequest(client, "POST", twitchCustomRewardsURL+"?"+q.Encode(), accessToken, payload)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	rewards, err := decodeData[CustomReward](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(rewards) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no reward returned")
+	}
+	return &rewards[0], nil
+}
+
+// UpdateRedemptionStatus marks a redemption as FULFILLED or CANCELED.
+// Canceling a redemption refunds the user's channel points.
+func UpdateRedemptionStatus(client *http.Client, accessToken, broadcasterID, rewardID, redemptionID, redemptionStatus string) error {
+	q := url.Values{}
+	q.Set("id", redemptionID)
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("reward_id", rewardID)
+
+	status, body, err := doHelixRequest(client, "PATCH", twitchRedemptionsURL+"?"+q.Encode(), accessToken, map[string]any{"status": redemptionStatus})
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
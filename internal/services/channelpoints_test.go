@@ -0,0 +1,118 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCustomRewards(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Query().Get("only_manageable_rewards") == "true"
+	})).Return(makeResp(http.StatusOK, `{"data": [{"broadcaster_id": "274637212", "id": "92af127c-7326-4483-a52b-b0da0be61c01", "title": "game analysis", "prompt": "", "cost": 50000, "is_enabled": true}]}`), nil)
+	client := buildMockClient(mockRT)
+
+	rewards, err := GetCustomRewards(client, "token", "274637212", true)
+
+	assert.NoError(t, err)
+	assert.Len(t, rewards, 1)
+	assert.Equal(t, 50000, rewards[0].Cost)
+	mockRT.AssertExpectations(t)
+}
+
+func TestCreateCustomReward(t *testing.T) {
+	tests := []struct {
+		name        string
+		prompt      string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			prompt:   "Which game?",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"broadcaster_id": "274637212", "id": "afaa7e34-6b17-49f0-a19a-d1e76eaaf673", "title": "game analysis 1v1", "prompt": "Which game?", "cost": 50000}]}`,
+		},
+		{
+			name:        "400 Bad Request - duplicate title",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"CREATE_CUSTOM_REWARD_DUPLICATE_REWARD"}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				body := decodeRequestBody(req)
+				_, hasPrompt := body["prompt"]
+				return body["title"] == "game analysis 1v1" && body["cost"] == float64(50000) && hasPrompt == (tt.prompt != "")
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			reward, err := CreateCustomReward(client, "token", "274637212", "game analysis 1v1", 50000, tt.prompt)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "afaa7e34-6b17-49f0-a19a-d1e76eaaf673", reward.ID)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateRedemptionStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"id": "17fa2df1-ad76-4804-bfa5-a40ef63efe63", "status": "CANCELED"}]}`,
+		},
+		{
+			name:        "403 Forbidden - reward created by another app",
+			respCode:    http.StatusForbidden,
+			respBody:    `{"error":"The ID in the Client-Id header must match the client ID used to create the custom reward."}`,
+			wantErr:     true,
+			errContains: "forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "PATCH" &&
+					req.URL.Query().Get("id") == "17fa2df1-ad76-4804-bfa5-a40ef63efe63" &&
+					req.URL.Query().Get("reward_id") == "92af127c-7326-4483-a52b-b0da0be61c01" &&
+					decodeRequestBody(req)["status"] == "CANCELED"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			err := UpdateRedemptionStatus(client, "token", "274637212", "92af127c-7326-4483-a52b-b0da0be61c01", "17fa2df1-ad76-4804-bfa5-a40ef63efe63", "CANCELED")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
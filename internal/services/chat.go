@@ -0,0 +1,131 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetChatSettings retrieves the current modes of the broadcaster's chat.
+func GetChatSettings(client *http.Client, accessToken, broadcasterID, moderatorID string) (*ChatSettings, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+
+	status, body, err := doHelixRequest(client, "GET", twitchChatSettingsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	data, err := decodeData[ChatSettings](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no chat settings returned")
+	}
+	return &data[0], nil
+}
+
+// UpdateChatSettings changes the broadcaster's chat modes. Only the
+// settings present in the map are changed, using the Twitch API field
+// names, e.g. "slow_mode" and "slow_mode_wait_time".
+func UpdateChatSettings(client *http.Client, accessToken, broadcasterID, moderatorID string, settings map[string]any) (*ChatSettings, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+
+	status, body, err := doHelixRequest(client, "PATCH", twitchChatSettingsURL+"?"+q.Encode(), accessToken, settings)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	data, err := decodeData[ChatSettings](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no chat settings returned")
+	}
+	return &data[0], nil
+}
+
+// SendChatAnnouncement sends a highlighted announcement to the broadcaster's chat.
+// The color is one of blue, green, orange, purple or primary; an empty color uses primary.
+func SendChatAnnouncement(client *http.Client, accessToken, broadcasterID, moderatorID, message, color string) error {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+
+	payload := map[string]any{
+		"message": message,
+	}
+	if color != "" {
+		payload["color"] = color
+	}
+
+	status, body, err := doHelixRequest(client, "POST", twitchChatAnnouncementsURL+"?"+q.Encode(), accessToken, payload)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNoContent {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
+
+// SendShoutout shouts out toBroadcasterID in fromBroadcasterID's chat.
+func SendShoutout(client *http.Client, accessToken, fromBroadcasterID, toBroadcasterID, moderatorID string) error {
+	q := url.Values{}
+	q.Set("from_broadcaster_id", fromBroadcasterID)
+	q.Set("to_broadcaster_id", toBroadcasterID)
+	q.Set("moderator_id", moderatorID)
+
+	status, body, err := doHelixRequest(client, "POST", twitchChatShoutoutsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNoContent {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
+
+// UpdateUserChatColor changes the color of the user's name in chat. The
+// color is a named color such as "blue" or, for Turbo and Prime users, a hex code.
+func UpdateUserChatColor(client *http.Client, accessToken, userID, color string) error {
+	q := url.Values{}
+	q.Set("user_id", userID)
+	q.Set("color", color)
+
+	status, body, err := doHelixRequest(client, "PUT", twitchChatColorURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNoContent {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
+
+// SendWhisper sends a private message from one user to another.
+func SendWhisper(client *http.Client, accessToken, fromUserID, toUserID, message string) error {
+	q := url.Values{}
+	q.Set("from_user_id", fromUserID)
+	q.Set("to_user_id", toUserID)
+
+	status, body, err := doHelixRequest(client, "POST", twitchWhispersURL+"?"+q.Encode(), accessToken, map[string]any{"message": message})
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNoContent {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateChatSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"broadcaster_id": "123", "slow_mode": true, "slow_mode_wait_time": 30, "follower_mode": false, "follower_mode_duration": null}]}`,
+		},
+		{
+			name:        "400 Bad Request - wait time out of range",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"The value in slow_mode_wait_time is outside the allowed range."}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+		{
+			name:        "403 Forbidden - not a moderator",
+			respCode:    http.StatusForbidden,
+			respBody:    `{"error":"The user is not one of the broadcaster's moderators."}`,
+			wantErr:     true,
+			errContains: "forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				body := decodeRequestBody(req)
+				return req.Method == "PATCH" && body["slow_mode"] == true && body["slow_mode_wait_time"] == float64(30)
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			settings, err := UpdateChatSettings(client, "token", "123", "123", map[string]any{
+				"slow_mode":           true,
+				"slow_mode_wait_time": 30,
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, settings.SlowMode)
+				assert.Equal(t, 30, *settings.SlowModeWaitTime)
+				assert.Nil(t, settings.FollowerModeDuration)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSendChatAnnouncement(t *testing.T) {
+	tests := []struct {
+		name      string
+		color     string
+		respCode  int
+		respBody  string
+		wantColor any
+		wantErr   bool
+	}{
+		{name: "204 No Content - default color", respCode: http.StatusNoContent, wantColor: nil},
+		{name: "204 No Content - purple", color: "purple", respCode: http.StatusNoContent, wantColor: "purple"},
+		{
+			name:      "429 Too Many Requests",
+			respCode:  http.StatusTooManyRequests,
+			respBody:  `{"error":"Too many announcements"}`,
+			wantColor: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				body := decodeRequestBody(req)
+				return body["message"] == "hello chat" && body["color"] == tt.wantColor
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			err := SendChatAnnouncement(client, "token", "123", "123", "hello chat", tt.color)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSendShoutout(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Query().Get("to_broadcaster_id") == "456"
+	})).Return(makeResp(http.StatusBadRequest, `{"error":"The broadcaster may not give themselves a Shoutout."}`), nil)
+	client := buildMockClient(mockRT)
+
+	err := SendShoutout(client, "token", "123", "456", "123")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "bad request")
+	mockRT.AssertExpectations(t)
+}
+
+func TestUpdateUserChatColor(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "PUT" && req.URL.Query().Get("color") == "blue_violet"
+	})).Return(makeResp(http.StatusNoContent, ""), nil)
+	client := buildMockClient(mockRT)
+
+	err := UpdateUserChatColor(client, "token", "123", "blue_violet")
+
+	assert.NoError(t, err)
+	mockRT.AssertExpectations(t)
+}
+
+func TestGetChatSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"broadcaster_id": "123", "emote_mode": true, "follower_mode": true, "follower_mode_duration": 10, "slow_mode": false, "slow_mode_wait_time": null, "subscriber_mode": false, "unique_chat_mode": true}]}`,
+		},
+		{
+			name:        "200 OK - no data",
+			respCode:    http.StatusOK,
+			respBody:    `{"data": []}`,
+			wantErr:     true,
+			errContains: "no chat settings",
+		},
+		{
+			name:        "400 Bad Request - missing broadcaster",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"Missing required parameter broadcaster_id"}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "GET" && req.URL.Query().Get("broadcaster_id") == "123"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			settings, err := GetChatSettings(client, "token", "123", "123")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, settings.EmoteMode)
+				assert.Equal(t, 10, *settings.FollowerModeDuration)
+				assert.Nil(t, settings.SlowModeWaitTime)
+				assert.True(t, settings.UniqueChatMode)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSendWhisper(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{name: "204 No Content", respCode: http.StatusNoContent},
+		{
+			name:        "401 Unauthorized - no verified phone number",
+			respCode:    http.StatusUnauthorized,
+			respBody:    `{"error":"The sending user must have a verified phone number."}`,
+			wantErr:     true,
+			errContains: "unauthorized",
+		},
+		{
+			name:        "429 Too Many Requests",
+			respCode:    http.StatusTooManyRequests,
+			respBody:    `{"error":"The sending user exceeded the number of whisper requests that they may make."}`,
+			wantErr:     true,
+			errContains: "too many requests",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.URL.Query().Get("from_user_id") == "123" &&
+					req.URL.Query().Get("to_user_id") == "456" &&
+					decodeRequestBody(req)["message"] == "psst"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			err := SendWhisper(client, "token", "123", "456", "psst")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// MaxMarkerDescriptionLength is the longest description Twitch accepts for
+// a stream marker, in characters.
+const MaxMarkerDescriptionLength = 140
+
+// CreateClip starts creating a clip of the broadcaster's live stream. The
+// clip captures up to the last 90 seconds of the broadcast.
+func CreateClip(client *http.Client, accessToken, broadcasterID string) (*Clip, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+
+	status, body, err := doHelixRequest(client, "POST", twitchClipsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusAccepted {
+		return nil, helixStatusError(status, body)
+	}
+
+	clips, err := decodeData[Clip](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(clips) == 0 {
+		return nil, fmt.Errorf("twitch API 202 Accepted but no clip returned")
+	}
+	return &clips[0], nil
+}
+
+// CreateStreamMarker marks the current position of userID's live stream,
+// with an optional description of up to MaxMarkerDescriptionLength characters.
+func CreateStreamMarker(client *http.Client, accessToken, userID, description string) (*StreamMarker, error) {
+	payload := map[string]any{"user_id": userID}
+	if description != "" {
+		payload["description"] = description
+	}
+
+	status, body, err := doHelixRequest(client, "POST", twitchStreamMarkersURL, accessToken, payload)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	markers, err := decodeData[StreamMarker](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(markers) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no stream marker returned")
+	}
+	return &markers[0], nil
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateClip(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "202 Accepted",
+			respCode: http.StatusAccepted,
+			respBody: `{"data": [{"id": "FiveWordsForClipSlug", "edit_url": "http://clips.twitch.tv/FiveWordsForClipSlug/edit"}]}`,
+		},
+		{
+			name:        "202 Accepted but no clip",
+			respCode:    http.StatusAccepted,
+			respBody:    `{"data": []}`,
+			wantErr:     true,
+			errContains: "no clip returned",
+		},
+		{
+			name:        "404 Not Found - broadcaster not live",
+			respCode:    http.StatusNotFound,
+			respBody:    `{"error":"Not Found","status":404,"message":"Clipping is not possible for an offline channel."}`,
+			wantErr:     true,
+			errContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "POST" && req.URL.Query().Get("broadcaster_id") == "1234"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			clip, err := CreateClip(client, "token", "1234")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "FiveWordsForClipSlug", clip.ID)
+				assert.Equal(t, "https://clips.twitch.tv/FiveWordsForClipSlug", clip.URL())
+				assert.Equal(t, "http://clips.twitch.tv/FiveWordsForClipSlug/edit", clip.EditURL)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateStreamMarker(t *testing.T) {
+	tests := []struct {
+		name            string
+		description     string
+		respCode        int
+		respBody        string
+		wantDescription any
+		wantErr         bool
+		errContains     string
+	}{
+		{
+			name:            "200 OK with description",
+			description:     "hello, this is a marker!",
+			respCode:        http.StatusOK,
+			respBody:        `{"data": [{"id": "123", "created_at": "2018-08-20T20:10:03Z", "description": "hello, this is a marker!", "position_seconds": 244}]}`,
+			wantDescription: "hello, this is a marker!",
+		},
+		{
+			name:     "200 OK without description",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"id": "123", "created_at": "2018-08-20T20:10:03Z", "description": "", "position_seconds": 244}]}`,
+		},
+		{
+			name:        "404 Not Found - not live",
+			respCode:    http.StatusNotFound,
+			respBody:    `{"error":"Not Found","status":404,"message":"The user is not streaming live."}`,
+			wantErr:     true,
+			errContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				body := decodeRequestBody(req)
+				return req.Method == "POST" && body["user_id"] == "1234" && body["description"] == tt.wantDescription
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			marker, err := CreateStreamMarker(client, "token", "1234", tt.description)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 244, marker.PositionSeconds)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
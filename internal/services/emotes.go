@@ -0,0 +1,50 @@
+package services
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// GetGlobalEmotes lists the emotes anyone can use in any chat.
+func GetGlobalEmotes(client *http.Client, accessToken string) ([]Emote, error) {
+	return getEmotes(client, twitchGlobalEmotesURL, accessToken)
+}
+
+// GetChannelEmotes lists the broadcaster's subscriber, follower and Bits
+// tier emotes.
+func GetChannelEmotes(client *http.Client, accessToken, broadcasterID string) ([]Emote, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	return getEmotes(client, twitchChannelEmotesURL+"?"+q.Encode(), accessToken)
+}
+
+// GetUserEmotes lists a page of the emotes userID can use in any chat,
+// starting after the cursor of the previous page. The returned cursor is
+// empty on the last page.
+func GetUserEmotes(client *http.Client, accessToken, userID, after string) ([]Emote, string, error) {
+	q := url.Values{}
+	q.Set("user_id", userID)
+	if after != "" {
+		q.Set("after", after)
+	}
+
+	status, body, err := doHelixRequest(client, "GET", twitchUserEmotesURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if status != http.StatusOK {
+		return nil, "", helixStatusError(status, body)
+	}
+	return decodePage[Emote](body)
+}
+
+func getEmotes(client *http.Client, endpoint, accessToken string) ([]Emote, error) {
+	status, body, err := doHelixRequest(client, "GET", endpoint, accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+	return decodeData[Emote](body)
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetGlobalEmotes(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantEmotes  int
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:       "200 OK",
+			respCode:   http.StatusOK,
+			respBody:   `{"data": [{"id": "196892", "name": "TwitchUnity", "images": {"url_1x": "https://static-cdn.jtvnw.net/emoticons/v2/196892/static/light/1.0"}, "format": ["static"], "scale": ["1.0", "2.0", "3.0"], "theme_mode": ["light", "dark"]}], "template": "https://static-cdn.jtvnw.net/emoticons/v2/{{id}}/{{format}}/{{theme_mode}}/{{scale}}"}`,
+			wantEmotes: 1,
+		},
+		{
+			name:        "401 Unauthorized",
+			respCode:    http.StatusUnauthorized,
+			respBody:    `{"error":"Unauthorized","status":401,"message":"Invalid OAuth token"}`,
+			wantErr:     true,
+			errContains: "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "GET" && req.URL.Path == "/helix/chat/emotes/global"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			emotes, err := GetGlobalEmotes(client, "token")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, emotes, tt.wantEmotes)
+				assert.Equal(t, "TwitchUnity", emotes[0].Name)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetChannelEmotes(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "GET" && req.URL.Path == "/helix/chat/emotes" && req.URL.Query().Get("broadcaster_id") == "141981764"
+	})).Return(makeResp(http.StatusOK, `{"data": [{"id": "304456832", "name": "twitchdevPitchfork", "tier": "1000", "emote_type": "subscriptions", "emote_set_id": "301590448", "format": ["static"], "scale": ["1.0", "2.0", "3.0"], "theme_mode": ["light", "dark"]}]}`), nil)
+	client := buildMockClient(mockRT)
+
+	emotes, err := GetChannelEmotes(client, "token", "141981764")
+
+	assert.NoError(t, err)
+	assert.Len(t, emotes, 1)
+	assert.Equal(t, "subscriptions", emotes[0].EmoteType)
+	assert.Equal(t, "1000", emotes[0].Tier)
+	mockRT.AssertExpectations(t)
+}
+
+func TestGetUserEmotes(t *testing.T) {
+	tests := []struct {
+		name       string
+		after      string
+		respBody   string
+		wantEmotes int
+		wantCursor string
+	}{
+		{
+			name:       "first page",
+			respBody:   `{"data": [{"id": "555555558", "name": "LUL", "emote_type": "globals", "emote_set_id": "0", "owner_id": "twitch", "format": ["static"], "scale": ["1.0", "2.0", "3.0"], "theme_mode": ["light", "dark"]}], "template": "https://static-cdn.jtvnw.net/emoticons/v2/{{id}}/{{format}}/{{theme_mode}}/{{scale}}", "pagination": {"cursor": "eyJiIjpudWxsLCJhIjp7IkN"}}`,
+			wantEmotes: 1,
+			wantCursor: "eyJiIjpudWxsLCJhIjp7IkN",
+		},
+		{
+			name:       "last page",
+			after:      "eyJiIjpudWxsLCJhIjp7IkN",
+			respBody:   `{"data": [], "pagination": {}}`,
+			wantEmotes: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "GET" && req.URL.Query().Get("user_id") == "1234" && req.URL.Query().Get("after") == tt.after
+			})).Return(makeResp(http.StatusOK, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			emotes, cursor, err := GetUserEmotes(client, "token", "1234", tt.after)
+
+			assert.NoError(t, err)
+			assert.Len(t, emotes, tt.wantEmotes)
+			assert.Equal(t, tt.wantCursor, cursor)
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,544 @@
+package services
+
+import "time"
+
+// ChatMessageFragment is one piece of a chat message: plain text,
+// an emote, a cheermote or a mention.
+type ChatMessageFragment struct {
+	Type      string `json:"type"`
+	Text      string `json:"text"`
+	Cheermote *struct {
+		Prefix string `json:"prefix"`
+		Bits   int    `json:"bits"`
+		Tier   int    `json:"tier"`
+	} `json:"cheermote"`
+	Emote *struct {
+		ID         string   `json:"id"`
+		EmoteSetID string   `json:"emote_set_id"`
+		OwnerID    string   `json:"owner_id"`
+		Format     []string `json:"format"`
+	} `json:"emote"`
+}
+
+// ChatBadge is a badge shown next to a chatter's name. Info holds extra
+// detail, such as the months subscribed for the subscriber badge.
+type ChatBadge struct {
+	SetID string `json:"set_id"`
+	ID    string `json:"id"`
+	Info  string `json:"info"`
+}
+
+// ChatMessageEvent is the event of a channel.chat.message notification.
+type ChatMessageEvent struct {
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	ChatterUserID        string `json:"chatter_user_id"`
+	ChatterUserLogin     string `json:"chatter_user_login"`
+	ChatterUserName      string `json:"chatter_user_name"`
+	MessageID            string `json:"message_id"`
+	Message              struct {
+		Text      string                `json:"text"`
+		Fragments []ChatMessageFragment `json:"fragments"`
+	} `json:"message"`
+	Color       string      `json:"color"`
+	Badges      []ChatBadge `json:"badges"`
+	MessageType string      `json:"message_type"`
+	Cheer       *struct {
+		Bits int `json:"bits"`
+	} `json:"cheer"`
+	Reply *struct {
+		ParentMessageID   string `json:"parent_message_id"`
+		ParentMessageBody string `json:"parent_message_body"`
+		ParentUserID      string `json:"parent_user_id"`
+		ParentUserLogin   string `json:"parent_user_login"`
+		ParentUserName    string `json:"parent_user_name"`
+	} `json:"reply"`
+}
+
+// ChatSettingsUpdateEvent is the event of a channel.chat_settings.update notification.
+type ChatSettingsUpdateEvent struct {
+	BroadcasterUserID           string `json:"broadcaster_user_id"`
+	EmoteMode                   bool   `json:"emote_mode"`
+	FollowerMode                bool   `json:"follower_mode"`
+	FollowerModeDurationMinutes *int   `json:"follower_mode_duration_minutes"`
+	SlowMode                    bool   `json:"slow_mode"`
+	SlowModeWaitTimeSeconds     *int   `json:"slow_mode_wait_time_seconds"`
+	SubscriberMode              bool   `json:"subscriber_mode"`
+	UniqueChatMode              bool   `json:"unique_chat_mode"`
+}
+
+// ChatSettings returns the chat modes the event changed to.
+func (e ChatSettingsUpdateEvent) ChatSettings() ChatSettings {
+	return ChatSettings{
+		BroadcasterID:        e.BroadcasterUserID,
+		EmoteMode:            e.EmoteMode,
+		FollowerMode:         e.FollowerMode,
+		FollowerModeDuration: e.FollowerModeDurationMinutes,
+		SlowMode:             e.SlowMode,
+		SlowModeWaitTime:     e.SlowModeWaitTimeSeconds,
+		SubscriberMode:       e.SubscriberMode,
+		UniqueChatMode:       e.UniqueChatMode,
+	}
+}
+
+// ChatNotificationEvent is the event of a channel.chat.notification
+// notification, such as an announcement. NoticeType says which kind.
+type ChatNotificationEvent struct {
+	BroadcasterUserID  string `json:"broadcaster_user_id"`
+	ChatterUserID      string `json:"chatter_user_id"`
+	ChatterUserLogin   string `json:"chatter_user_login"`
+	ChatterUserName    string `json:"chatter_user_name"`
+	ChatterIsAnonymous bool   `json:"chatter_is_anonymous"`
+	Color              string `json:"color"`
+	SystemMessage      string `json:"system_message"`
+	MessageID          string `json:"message_id"`
+	Message            struct {
+		Text      string                `json:"text"`
+		Fragments []ChatMessageFragment `json:"fragments"`
+	} `json:"message"`
+	NoticeType   string `json:"notice_type"`
+	Announcement *struct {
+		Color string `json:"color"`
+	} `json:"announcement"`
+}
+
+// ShoutoutCreateEvent is the event of a channel.shoutout.create notification.
+type ShoutoutCreateEvent struct {
+	BroadcasterUserID      string    `json:"broadcaster_user_id"`
+	ToBroadcasterUserID    string    `json:"to_broadcaster_user_id"`
+	ToBroadcasterUserLogin string    `json:"to_broadcaster_user_login"`
+	ToBroadcasterUserName  string    `json:"to_broadcaster_user_name"`
+	ModeratorUserID        string    `json:"moderator_user_id"`
+	ModeratorUserLogin     string    `json:"moderator_user_login"`
+	ModeratorUserName      string    `json:"moderator_user_name"`
+	ViewerCount            int       `json:"viewer_count"`
+	StartedAt              time.Time `json:"started_at"`
+	CooldownEndsAt         time.Time `json:"cooldown_ends_at"`
+	TargetCooldownEndsAt   time.Time `json:"target_cooldown_ends_at"`
+}
+
+// ShoutoutReceiveEvent is the event of a channel.shoutout.receive notification.
+type ShoutoutReceiveEvent struct {
+	BroadcasterUserID        string    `json:"broadcaster_user_id"`
+	FromBroadcasterUserID    string    `json:"from_broadcaster_user_id"`
+	FromBroadcasterUserLogin string    `json:"from_broadcaster_user_login"`
+	FromBroadcasterUserName  string    `json:"from_broadcaster_user_name"`
+	ViewerCount              int       `json:"viewer_count"`
+	StartedAt                time.Time `json:"started_at"`
+}
+
+// WhisperMessageEvent is the event of a user.whisper.message notification.
+type WhisperMessageEvent struct {
+	FromUserID    string `json:"from_user_id"`
+	FromUserLogin string `json:"from_user_login"`
+	FromUserName  string `json:"from_user_name"`
+	ToUserID      string `json:"to_user_id"`
+	ToUserLogin   string `json:"to_user_login"`
+	ToUserName    string `json:"to_user_name"`
+	WhisperID     string `json:"whisper_id"`
+	Whisper       struct {
+		Text string `json:"text"`
+	} `json:"whisper"`
+}
+
+// StreamOnlineEvent is the event of a stream.online notification.
+type StreamOnlineEvent struct {
+	ID                   string    `json:"id"`
+	BroadcasterUserID    string    `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string    `json:"broadcaster_user_login"`
+	BroadcasterUserName  string    `json:"broadcaster_user_name"`
+	Type                 string    `json:"type"`
+	StartedAt            time.Time `json:"started_at"`
+}
+
+// StreamOfflineEvent is the event of a stream.offline notification.
+type StreamOfflineEvent struct {
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+}
+
+// ChannelUpdateEvent is the event of a channel.update notification.
+type ChannelUpdateEvent struct {
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	Title                string `json:"title"`
+	Language             string `json:"language"`
+	CategoryID           string `json:"category_id"`
+	CategoryName         string `json:"category_name"`
+}
+
+// FollowEvent is the event of a channel.follow notification.
+type FollowEvent struct {
+	UserID            string    `json:"user_id"`
+	UserLogin         string    `json:"user_login"`
+	UserName          string    `json:"user_name"`
+	BroadcasterUserID string    `json:"broadcaster_user_id"`
+	FollowedAt        time.Time `json:"followed_at"`
+}
+
+// SubscribeEvent is the event of a channel.subscribe notification.
+type SubscribeEvent struct {
+	UserID            string `json:"user_id"`
+	UserLogin         string `json:"user_login"`
+	UserName          string `json:"user_name"`
+	BroadcasterUserID string `json:"broadcaster_user_id"`
+	Tier              string `json:"tier"`
+	IsGift            bool   `json:"is_gift"`
+}
+
+// SubscriptionGiftEvent is the event of a channel.subscription.gift
+// notification. The user fields are empty when the gifter is anonymous.
+type SubscriptionGiftEvent struct {
+	UserID            string `json:"user_id"`
+	UserLogin         string `json:"user_login"`
+	UserName          string `json:"user_name"`
+	BroadcasterUserID string `json:"broadcaster_user_id"`
+	Total             int    `json:"total"`
+	Tier              string `json:"tier"`
+	CumulativeTotal   *int   `json:"cumulative_total"`
+	IsAnonymous       bool   `json:"is_anonymous"`
+}
+
+// SubscriptionMessageEvent is the event of a channel.subscription.message
+// notification, sent when a user shares their resubscription in chat.
+type SubscriptionMessageEvent struct {
+	UserID            string `json:"user_id"`
+	UserLogin         string `json:"user_login"`
+	UserName          string `json:"user_name"`
+	BroadcasterUserID string `json:"broadcaster_user_id"`
+	Tier              string `json:"tier"`
+	Message           struct {
+		Text string `json:"text"`
+	} `json:"message"`
+	CumulativeMonths int  `json:"cumulative_months"`
+	StreakMonths     *int `json:"streak_months"`
+	DurationMonths   int  `json:"duration_months"`
+}
+
+// RedemptionAddEvent is the event of a
+// channel.channel_points_custom_reward_redemption.add notification.
+type RedemptionAddEvent struct {
+	ID                string `json:"id"`
+	BroadcasterUserID string `json:"broadcaster_user_id"`
+	UserID            string `json:"user_id"`
+	UserLogin         string `json:"user_login"`
+	UserName          string `json:"user_name"`
+	UserInput         string `json:"user_input"`
+	Status            string `json:"status"`
+	Reward            struct {
+		ID     string `json:"id"`
+		Title  string `json:"title"`
+		Cost   int    `json:"cost"`
+		Prompt string `json:"prompt"`
+	} `json:"reward"`
+	RedeemedAt time.Time `json:"redeemed_at"`
+}
+
+// PollEvent is the event of a channel.poll.begin, channel.poll.progress
+// or channel.poll.end notification. EndsAt is only set while the poll
+// runs, Status and EndedAt only once it has ended.
+type PollEvent struct {
+	ID                   string       `json:"id"`
+	BroadcasterUserID    string       `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string       `json:"broadcaster_user_login"`
+	BroadcasterUserName  string       `json:"broadcaster_user_name"`
+	Title                string       `json:"title"`
+	Choices              []PollChoice `json:"choices"`
+	ChannelPointsVoting  struct {
+		IsEnabled     bool `json:"is_enabled"`
+		AmountPerVote int  `json:"amount_per_vote"`
+	} `json:"channel_points_voting"`
+	Status    string     `json:"status"`
+	StartedAt time.Time  `json:"started_at"`
+	EndsAt    *time.Time `json:"ends_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+}
+
+// PredictionEvent is the event of a channel.prediction.begin,
+// channel.prediction.progress, channel.prediction.lock or
+// channel.prediction.end notification. LocksAt is only set while the
+// prediction takes predictions, WinningOutcomeID and Status only once it
+// has ended.
+type PredictionEvent struct {
+	ID                   string              `json:"id"`
+	BroadcasterUserID    string              `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string              `json:"broadcaster_user_login"`
+	BroadcasterUserName  string              `json:"broadcaster_user_name"`
+	Title                string              `json:"title"`
+	WinningOutcomeID     string              `json:"winning_outcome_id"`
+	Outcomes             []PredictionOutcome `json:"outcomes"`
+	Status               string              `json:"status"`
+	StartedAt            time.Time           `json:"started_at"`
+	LocksAt              *time.Time          `json:"locks_at"`
+	LockedAt             *time.Time          `json:"locked_at"`
+	EndedAt              *time.Time          `json:"ended_at"`
+}
+
+// RaidEvent is the event of a channel.raid notification.
+type RaidEvent struct {
+	FromBroadcasterUserID    string `json:"from_broadcaster_user_id"`
+	FromBroadcasterUserLogin string `json:"from_broadcaster_user_login"`
+	FromBroadcasterUserName  string `json:"from_broadcaster_user_name"`
+	ToBroadcasterUserID      string `json:"to_broadcaster_user_id"`
+	ToBroadcasterUserLogin   string `json:"to_broadcaster_user_login"`
+	ToBroadcasterUserName    string `json:"to_broadcaster_user_name"`
+	Viewers                  int    `json:"viewers"`
+}
+
+// HypeTrainEvent is the event of a channel.hype_train.begin,
+// channel.hype_train.progress or channel.hype_train.end notification.
+// Progress, Goal and ExpiresAt are only set while the hype train runs,
+// EndedAt and CooldownEndsAt only once it has ended.
+type HypeTrainEvent struct {
+	ID                   string `json:"id"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	Type                 string `json:"type"`
+	Level                int    `json:"level"`
+	Total                int    `json:"total"`
+	Progress             int    `json:"progress"`
+	Goal                 int    `json:"goal"`
+	TopContributions     []struct {
+		UserID    string `json:"user_id"`
+		UserLogin string `json:"user_login"`
+		UserName  string `json:"user_name"`
+		Type      string `json:"type"`
+		Total     int    `json:"total"`
+	} `json:"top_contributions"`
+	StartedAt      time.Time  `json:"started_at"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+	EndedAt        *time.Time `json:"ended_at"`
+	CooldownEndsAt *time.Time `json:"cooldown_ends_at"`
+}
+
+// AdBreakBeginEvent is the event of a channel.ad_break.begin notification.
+type AdBreakBeginEvent struct {
+	DurationSeconds      int       `json:"duration_seconds"`
+	StartedAt            time.Time `json:"started_at"`
+	IsAutomatic          bool      `json:"is_automatic"`
+	BroadcasterUserID    string    `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string    `json:"broadcaster_user_login"`
+	BroadcasterUserName  string    `json:"broadcaster_user_name"`
+	RequesterUserID      string    `json:"requester_user_id"`
+	RequesterUserLogin   string    `json:"requester_user_login"`
+	RequesterUserName    string    `json:"requester_user_name"`
+}
+
+// ModeratedUser is the user a moderation action was taken against.
+type ModeratedUser struct {
+	UserID    string `json:"user_id"`
+	UserLogin string `json:"user_login"`
+	UserName  string `json:"user_name"`
+}
+
+// ModerateEvent is the event of a channel.moderate notification. Only the
+// field named after Action is set, e.g. Ban for "ban" and Followers for
+// "followers".
+type ModerateEvent struct {
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	ModeratorUserID      string `json:"moderator_user_id"`
+	ModeratorUserLogin   string `json:"moderator_user_login"`
+	ModeratorUserName    string `json:"moderator_user_name"`
+	Action               string `json:"action"`
+	Followers            *struct {
+		FollowDurationMinutes int `json:"follow_duration_minutes"`
+	} `json:"followers"`
+	Slow *struct {
+		WaitTimeSeconds int `json:"wait_time_seconds"`
+	} `json:"slow"`
+	VIP   *ModeratedUser `json:"vip"`
+	Unvip *ModeratedUser `json:"unvip"`
+	Mod   *ModeratedUser `json:"mod"`
+	Unmod *ModeratedUser `json:"unmod"`
+	Ban   *struct {
+		ModeratedUser
+		Reason string `json:"reason"`
+	} `json:"ban"`
+	Unban   *ModeratedUser `json:"unban"`
+	Timeout *struct {
+		ModeratedUser
+		Reason    string    `json:"reason"`
+		ExpiresAt time.Time `json:"expires_at"`
+	} `json:"timeout"`
+	Untimeout *ModeratedUser `json:"untimeout"`
+	Raid      *struct {
+		ModeratedUser
+		ViewerCount int `json:"viewer_count"`
+	} `json:"raid"`
+	Unraid *ModeratedUser `json:"unraid"`
+	Delete *struct {
+		ModeratedUser
+		MessageID   string `json:"message_id"`
+		MessageBody string `json:"message_body"`
+	} `json:"delete"`
+	AutomodTerms *struct {
+		Action      string   `json:"action"`
+		List        string   `json:"list"`
+		Terms       []string `json:"terms"`
+		FromAutomod bool     `json:"from_automod"`
+	} `json:"automod_terms"`
+	UnbanRequest *struct {
+		ModeratedUser
+		IsApproved       bool   `json:"is_approved"`
+		ModeratorMessage string `json:"moderator_message"`
+	} `json:"unban_request"`
+	Warn *struct {
+		ModeratedUser
+		Reason         string   `json:"reason"`
+		ChatRulesCited []string `json:"chat_rules_cited"`
+	} `json:"warn"`
+}
+
+// ClearUserMessagesEvent is the event of a channel.chat.clear_user_messages
+// notification, sent when a user is banned or timed out.
+type ClearUserMessagesEvent struct {
+	BroadcasterUserID string `json:"broadcaster_user_id"`
+	TargetUserID      string `json:"target_user_id"`
+	TargetUserLogin   string `json:"target_user_login"`
+	TargetUserName    string `json:"target_user_name"`
+}
+
+// MessageDeleteEvent is the event of a channel.chat.message_delete notification.
+type MessageDeleteEvent struct {
+	BroadcasterUserID string `json:"broadcaster_user_id"`
+	TargetUserID      string `json:"target_user_id"`
+	TargetUserLogin   string `json:"target_user_login"`
+	TargetUserName    string `json:"target_user_name"`
+	MessageID         string `json:"message_id"`
+}
+
+// AutoModMessageEvent is the event of an automod.message.hold or
+// automod.message.update notification. The moderator and Status are only
+// set on updates.
+type AutoModMessageEvent struct {
+	BroadcasterUserID  string `json:"broadcaster_user_id"`
+	UserID             string `json:"user_id"`
+	UserLogin          string `json:"user_login"`
+	UserName           string `json:"user_name"`
+	ModeratorUserID    string `json:"moderator_user_id"`
+	ModeratorUserLogin string `json:"moderator_user_login"`
+	ModeratorUserName  string `json:"moderator_user_name"`
+	MessageID          string `json:"message_id"`
+	Message            struct {
+		Text string `json:"text"`
+	} `json:"message"`
+	Category string    `json:"category"`
+	Level    int       `json:"level"`
+	Status   string    `json:"status"`
+	HeldAt   time.Time `json:"held_at"`
+}
+
+// UnbanRequestCreateEvent is the event of a channel.unban_request.create notification.
+type UnbanRequestCreateEvent struct {
+	ID                   string    `json:"id"`
+	BroadcasterUserID    string    `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string    `json:"broadcaster_user_login"`
+	BroadcasterUserName  string    `json:"broadcaster_user_name"`
+	UserID               string    `json:"user_id"`
+	UserLogin            string    `json:"user_login"`
+	UserName             string    `json:"user_name"`
+	Text                 string    `json:"text"`
+	CreatedAt            time.Time `json:"created_at"`
+}
+
+// UnbanRequestResolveEvent is the event of a channel.unban_request.resolve
+// notification. The moderator is empty when the user canceled the request.
+type UnbanRequestResolveEvent struct {
+	ID                   string `json:"id"`
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	ModeratorUserID      string `json:"moderator_user_id"`
+	ModeratorUserLogin   string `json:"moderator_user_login"`
+	ModeratorUserName    string `json:"moderator_user_name"`
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+	ResolutionText       string `json:"resolution_text"`
+	Status               string `json:"status"`
+}
+
+// ShieldModeEvent is the event of a channel.shield_mode.begin or
+// channel.shield_mode.end notification. StartedAt is only set on begin,
+// EndedAt only on end.
+type ShieldModeEvent struct {
+	BroadcasterUserID    string     `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string     `json:"broadcaster_user_login"`
+	BroadcasterUserName  string     `json:"broadcaster_user_name"`
+	ModeratorUserID      string     `json:"moderator_user_id"`
+	ModeratorUserLogin   string     `json:"moderator_user_login"`
+	ModeratorUserName    string     `json:"moderator_user_name"`
+	StartedAt            *time.Time `json:"started_at"`
+	EndedAt              *time.Time `json:"ended_at"`
+}
+
+// SuspiciousUserMessageEvent is the event of a
+// channel.suspicious_user.message notification, sent for each message from
+// a user being monitored or restricted. LowTrustStatus is none,
+// active_monitoring or restricted.
+type SuspiciousUserMessageEvent struct {
+	BroadcasterUserID    string   `json:"broadcaster_user_id"`
+	UserID               string   `json:"user_id"`
+	UserLogin            string   `json:"user_login"`
+	UserName             string   `json:"user_name"`
+	LowTrustStatus       string   `json:"low_trust_status"`
+	SharedBanChannelIDs  []string `json:"shared_ban_channel_ids"`
+	Types                []string `json:"types"`
+	BanEvasionEvaluation string   `json:"ban_evasion_evaluation"`
+	Message              struct {
+		MessageID string                `json:"message_id"`
+		Text      string                `json:"text"`
+		Fragments []ChatMessageFragment `json:"fragments"`
+	} `json:"message"`
+}
+
+// SuspiciousUserUpdateEvent is the event of a channel.suspicious_user.update
+// notification, sent when a moderator changes a user's low trust status.
+type SuspiciousUserUpdateEvent struct {
+	BroadcasterUserID  string `json:"broadcaster_user_id"`
+	ModeratorUserID    string `json:"moderator_user_id"`
+	ModeratorUserLogin string `json:"moderator_user_login"`
+	ModeratorUserName  string `json:"moderator_user_name"`
+	UserID             string `json:"user_id"`
+	UserLogin          string `json:"user_login"`
+	UserName           string `json:"user_name"`
+	LowTrustStatus     string `json:"low_trust_status"`
+}
+
+// WarningSendEvent is the event of a channel.warning.send notification.
+type WarningSendEvent struct {
+	BroadcasterUserID  string   `json:"broadcaster_user_id"`
+	ModeratorUserID    string   `json:"moderator_user_id"`
+	ModeratorUserLogin string   `json:"moderator_user_login"`
+	ModeratorUserName  string   `json:"moderator_user_name"`
+	UserID             string   `json:"user_id"`
+	UserLogin          string   `json:"user_login"`
+	UserName           string   `json:"user_name"`
+	Reason             string   `json:"reason"`
+	ChatRulesCited     []string `json:"chat_rules_cited"`
+}
+
+// WarningAcknowledgeEvent is the event of a channel.warning.acknowledge notification.
+type WarningAcknowledgeEvent struct {
+	BroadcasterUserID string `json:"broadcaster_user_id"`
+	UserID            string `json:"user_id"`
+	UserLogin         string `json:"user_login"`
+	UserName          string `json:"user_name"`
+}
+
+// RoleChangeEvent is the event of a channel.moderator.add,
+// channel.moderator.remove, channel.vip.add or channel.vip.remove notification.
+type RoleChangeEvent struct {
+	BroadcasterUserID    string `json:"broadcaster_user_id"`
+	BroadcasterUserLogin string `json:"broadcaster_user_login"`
+	BroadcasterUserName  string `json:"broadcaster_user_name"`
+	UserID               string `json:"user_id"`
+	UserLogin            string `json:"user_login"`
+	UserName             string `json:"user_name"`
+}
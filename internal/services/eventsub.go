@@ -1,6 +1,7 @@
 package services
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/gorilla/websocket"
@@ -36,3 +37,23 @@ func HandleEvent(conn *websocket.Conn) ([]byte, error) {
 
 	return msg, nil
 }
+
+// ParseNotification decodes a message read from the EventSub websocket.
+// Callers should check Metadata.MessageType, as keepalive and other
+// session messages decode without an event.
+func ParseNotification(msg []byte) (Notification, error) {
+	var n Notification
+	if err := json.Unmarshal(msg, &n); err != nil {
+		return Notification{}, fmt.Errorf("failed to decode EventSub message: %w", err)
+	}
+	return n, nil
+}
+
+// DecodeEvent decodes the event carried by a notification.
+func DecodeEvent[T any](n Notification) (T, error) {
+	var event T
+	if err := json.Unmarshal(n.Payload.Event, &event); err != nil {
+		return event, fmt.Errorf("failed to decode %s event: %w", n.Payload.Subscription.Type, err)
+	}
+	return event, nil
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const announcementNotification = `{
+	"metadata": {
+		"message_id": "befa7b53-d79d-478f-86b9-120f112b044e",
+		"message_type": "notification",
+		"message_timestamp": "2022-11-16T10:11:12.464757833Z",
+		"subscription_type": "channel.chat.notification",
+		"subscription_version": "1"
+	},
+	"payload": {
+		"subscription": {"id": "f1c2a387-161a-49f9-a165-0f21d7a4e1c4", "type": "channel.chat.notification", "version": "1"},
+		"event": {
+			"broadcaster_user_id": "1971641",
+			"chatter_user_id": "4145994",
+			"chatter_user_login": "viptest",
+			"chatter_user_name": "viptest",
+			"chatter_is_anonymous": false,
+			"color": "#FF0000",
+			"system_message": "",
+			"message_id": "d62235c8-47ff-a4f4--84e8-5a29a65a9c03",
+			"message": {"text": "hello chat", "fragments": [{"type": "text", "text": "hello chat"}]},
+			"notice_type": "announcement",
+			"announcement": {"color": "PURPLE"}
+		}
+	}
+}`
+
+func TestParseNotification(t *testing.T) {
+	n, err := ParseNotification([]byte(announcementNotification))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "notification", n.Metadata.MessageType)
+	assert.Equal(t, "channel.chat.notification", n.Payload.Subscription.Type)
+}
+
+func TestParseNotification_Keepalive(t *testing.T) {
+	n, err := ParseNotification([]byte(`{"metadata": {"message_id": "84c1e79a", "message_type": "session_keepalive", "message_timestamp": "2023-07-19T10:11:12.634234626Z"}, "payload": {}}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "session_keepalive", n.Metadata.MessageType)
+	assert.Empty(t, n.Payload.Event)
+}
+
+func TestDecodeEvent(t *testing.T) {
+	n, err := ParseNotification([]byte(announcementNotification))
+	assert.NoError(t, err)
+
+	event, err := DecodeEvent[ChatNotificationEvent](n)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "announcement", event.NoticeType)
+	assert.Equal(t, "hello chat", event.Message.Text)
+	assert.Equal(t, "PURPLE", event.Announcement.Color)
+}
+
+func TestDecodeEvent_AnonymousGift(t *testing.T) {
+	n, err := ParseNotification([]byte(`{
+		"metadata": {"message_id": "1", "message_type": "notification", "message_timestamp": "2023-07-19T10:11:12.634234626Z", "subscription_type": "channel.subscription.gift", "subscription_version": "1"},
+		"payload": {
+			"subscription": {"id": "f1c2a387", "type": "channel.subscription.gift", "version": "1"},
+			"event": {"user_id": null, "user_login": null, "user_name": null, "broadcaster_user_id": "1337", "total": 2, "tier": "1000", "cumulative_total": null, "is_anonymous": true}
+		}
+	}`))
+	assert.NoError(t, err)
+
+	event, err := DecodeEvent[SubscriptionGiftEvent](n)
+
+	assert.NoError(t, err)
+	assert.True(t, event.IsAnonymous)
+	assert.Equal(t, "", event.UserName)
+	assert.Equal(t, 2, event.Total)
+	assert.Nil(t, event.CumulativeTotal)
+}
+
+func TestDecodeEvent_ModerateTimeout(t *testing.T) {
+	n, err := ParseNotification([]byte(`{
+		"metadata": {"message_id": "1", "message_type": "notification", "message_timestamp": "2023-07-19T10:11:12.634234626Z", "subscription_type": "channel.moderate", "subscription_version": "2"},
+		"payload": {
+			"subscription": {"id": "7297f7eb", "type": "channel.moderate", "version": "2"},
+			"event": {
+				"broadcaster_user_id": "1337", "broadcaster_user_login": "glowillig", "broadcaster_user_name": "glowillig",
+				"moderator_user_id": "424596340", "moderator_user_login": "quotrok", "moderator_user_name": "quotrok",
+				"action": "timeout",
+				"followers": null, "slow": null, "vip": null, "unvip": null, "mod": null, "unmod": null, "ban": null, "unban": null,
+				"timeout": {"user_id": "141981764", "user_login": "twitchdev", "user_name": "TwitchDev", "reason": "spam", "expires_at": "2024-02-23T21:12:33.771005262Z"},
+				"untimeout": null, "raid": null, "unraid": null, "delete": null, "automod_terms": null, "unban_request": null, "warn": null
+			}
+		}
+	}`))
+	assert.NoError(t, err)
+
+	event, err := DecodeEvent[ModerateEvent](n)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "timeout", event.Action)
+	assert.Equal(t, "TwitchDev", event.Timeout.UserName)
+	assert.Equal(t, "spam", event.Timeout.Reason)
+	assert.Nil(t, event.Ban)
+}
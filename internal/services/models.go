@@ -1,6 +1,9 @@
 package services
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // UserInfo represents a user from the Twitch API.
 type UserInfo struct {
@@ -16,6 +19,312 @@ type UserInfo struct {
 	CreatedAt       string `json:"created_at"`
 }
 
+// Follower represents a user following a channel.
+type Follower struct {
+	UserID     string    `json:"user_id"`
+	UserLogin  string    `json:"user_login"`
+	UserName   string    `json:"user_name"`
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+// Subscription represents a user's subscription to a broadcaster.
+type Subscription struct {
+	BroadcasterID string `json:"broadcaster_id"`
+	GifterID      string `json:"gifter_id"`
+	GifterLogin   string `json:"gifter_login"`
+	GifterName    string `json:"gifter_name"`
+	IsGift        bool   `json:"is_gift"`
+	PlanName      string `json:"plan_name"`
+	Tier          string `json:"tier"`
+	UserID        string `json:"user_id"`
+	UserLogin     string `json:"user_login"`
+	UserName      string `json:"user_name"`
+}
+
+// ChatSettings represents the modes of a broadcaster's chat.
+type ChatSettings struct {
+	BroadcasterID                 string `json:"broadcaster_id"`
+	EmoteMode                     bool   `json:"emote_mode"`
+	FollowerMode                  bool   `json:"follower_mode"`
+	FollowerModeDuration          *int   `json:"follower_mode_duration"`
+	ModeratorID                   string `json:"moderator_id"`
+	NonModeratorChatDelay         bool   `json:"non_moderator_chat_delay"`
+	NonModeratorChatDelayDuration *int   `json:"non_moderator_chat_delay_duration"`
+	SlowMode                      bool   `json:"slow_mode"`
+	SlowModeWaitTime              *int   `json:"slow_mode_wait_time"`
+	SubscriberMode                bool   `json:"subscriber_mode"`
+	UniqueChatMode                bool   `json:"unique_chat_mode"`
+}
+
+// Raid represents a raid that is waiting to start.
+type Raid struct {
+	CreatedAt time.Time `json:"created_at"`
+	IsMature  bool      `json:"is_mature"`
+}
+
+// Stream represents a live stream.
+type Stream struct {
+	ID           string    `json:"id"`
+	UserID       string    `json:"user_id"`
+	UserLogin    string    `json:"user_login"`
+	UserName     string    `json:"user_name"`
+	GameID       string    `json:"game_id"`
+	GameName     string    `json:"game_name"`
+	Type         string    `json:"type"`
+	Title        string    `json:"title"`
+	Tags         []string  `json:"tags"`
+	ViewerCount  int       `json:"viewer_count"`
+	StartedAt    time.Time `json:"started_at"`
+	Language     string    `json:"language"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+	IsMature     bool      `json:"is_mature"`
+}
+
+// ChannelInfo represents a broadcaster's channel information.
+type ChannelInfo struct {
+	BroadcasterID       string   `json:"broadcaster_id"`
+	BroadcasterLogin    string   `json:"broadcaster_login"`
+	BroadcasterName     string   `json:"broadcaster_name"`
+	BroadcasterLanguage string   `json:"broadcaster_language"`
+	GameID              string   `json:"game_id"`
+	GameName            string   `json:"game_name"`
+	Title               string   `json:"title"`
+	Delay               int      `json:"delay"`
+	Tags                []string `json:"tags"`
+}
+
+// Category represents a game or other category a stream can be in.
+type Category struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	BoxArtURL string `json:"box_art_url"`
+}
+
+// BitsLeaderboard represents the top cheerers over a period.
+type BitsLeaderboard struct {
+	Data      []BitsLeaderboardEntry `json:"data"`
+	DateRange struct {
+		StartedAt time.Time `json:"started_at"`
+		EndedAt   time.Time `json:"ended_at"`
+	} `json:"date_range"`
+	Total int `json:"total"`
+}
+
+// BitsLeaderboardEntry represents a cheerer's place on a bits leaderboard.
+type BitsLeaderboardEntry struct {
+	UserID    string `json:"user_id"`
+	UserLogin string `json:"user_login"`
+	UserName  string `json:"user_name"`
+	Rank      int    `json:"rank"`
+	Score     int    `json:"score"`
+}
+
+// CustomReward represents a channel points reward.
+type CustomReward struct {
+	BroadcasterID                     string `json:"broadcaster_id"`
+	ID                                string `json:"id"`
+	Title                             string `json:"title"`
+	Prompt                            string `json:"prompt"`
+	Cost                              int    `json:"cost"`
+	BackgroundColor                   string `json:"background_color"`
+	IsEnabled                         bool   `json:"is_enabled"`
+	IsUserInputRequired               bool   `json:"is_user_input_required"`
+	IsPaused                          bool   `json:"is_paused"`
+	IsInStock                         bool   `json:"is_in_stock"`
+	ShouldRedemptionsSkipRequestQueue bool   `json:"should_redemptions_skip_request_queue"`
+}
+
+// Poll represents a poll in a channel.
+type Poll struct {
+	ID                         string       `json:"id"`
+	BroadcasterID              string       `json:"broadcaster_id"`
+	Title                      string       `json:"title"`
+	Choices                    []PollChoice `json:"choices"`
+	ChannelPointsVotingEnabled bool         `json:"channel_points_voting_enabled"`
+	ChannelPointsPerVote       int          `json:"channel_points_per_vote"`
+	Status                     string       `json:"status"`
+	Duration                   int          `json:"duration"`
+	StartedAt                  time.Time    `json:"started_at"`
+	EndedAt                    *time.Time   `json:"ended_at"`
+}
+
+// PollChoice represents one of the choices viewers can vote for in a poll.
+type PollChoice struct {
+	ID                 string `json:"id"`
+	Title              string `json:"title"`
+	Votes              int    `json:"votes"`
+	ChannelPointsVotes int    `json:"channel_points_votes"`
+	BitsVotes          int    `json:"bits_votes"`
+}
+
+// Prediction represents a prediction in a channel.
+type Prediction struct {
+	ID               string              `json:"id"`
+	BroadcasterID    string              `json:"broadcaster_id"`
+	Title            string              `json:"title"`
+	WinningOutcomeID string              `json:"winning_outcome_id"`
+	Outcomes         []PredictionOutcome `json:"outcomes"`
+	PredictionWindow int                 `json:"prediction_window"`
+	Status           string              `json:"status"`
+	CreatedAt        time.Time           `json:"created_at"`
+	EndedAt          *time.Time          `json:"ended_at"`
+	LockedAt         *time.Time          `json:"locked_at"`
+}
+
+// PredictionOutcome represents one of the outcomes viewers can predict.
+type PredictionOutcome struct {
+	ID            string      `json:"id"`
+	Title         string      `json:"title"`
+	Users         int         `json:"users"`
+	ChannelPoints int         `json:"channel_points"`
+	TopPredictors []Predictor `json:"top_predictors"`
+	Color         string      `json:"color"`
+}
+
+// Predictor represents a viewer who spent channel points on an outcome.
+type Predictor struct {
+	UserID            string `json:"user_id"`
+	UserLogin         string `json:"user_login"`
+	UserName          string `json:"user_name"`
+	ChannelPointsUsed int    `json:"channel_points_used"`
+	ChannelPointsWon  int    `json:"channel_points_won"`
+}
+
+// Commercial represents a commercial started on a stream.
+type Commercial struct {
+	Length     int    `json:"length"`
+	Message    string `json:"message"`
+	RetryAfter int    `json:"retry_after"`
+}
+
+// AdSchedule represents a channel's ad schedule. The times are RFC3339
+// timestamps, empty when there is no ad scheduled or the channel is not live.
+type AdSchedule struct {
+	NextAdAt        string `json:"next_ad_at"`
+	LastAdAt        string `json:"last_ad_at"`
+	Duration        int    `json:"duration"`
+	PrerollFreeTime int    `json:"preroll_free_time"`
+	SnoozeCount     int    `json:"snooze_count"`
+	SnoozeRefreshAt string `json:"snooze_refresh_at"`
+}
+
+// AutoModSettings represents a channel's AutoMod levels, from 0 (off) to
+// 4 (most filtering). OverallLevel is nil when the levels were set
+// individually.
+type AutoModSettings struct {
+	BroadcasterID           string `json:"broadcaster_id"`
+	ModeratorID             string `json:"moderator_id"`
+	OverallLevel            *int   `json:"overall_level"`
+	Disability              int    `json:"disability"`
+	Aggression              int    `json:"aggression"`
+	SexualitySexOrGender    int    `json:"sexuality_sex_or_gender"`
+	Misogyny                int    `json:"misogyny"`
+	Bullying                int    `json:"bullying"`
+	Swearing                int    `json:"swearing"`
+	RaceEthnicityOrReligion int    `json:"race_ethnicity_or_religion"`
+	SexBasedTerms           int    `json:"sex_based_terms"`
+}
+
+// BlockedTerm represents a word or phrase blocked in a channel's chat.
+type BlockedTerm struct {
+	BroadcasterID string     `json:"broadcaster_id"`
+	ModeratorID   string     `json:"moderator_id"`
+	ID            string     `json:"id"`
+	Text          string     `json:"text"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+}
+
+// UnbanRequest represents a banned user's request to be unbanned.
+type UnbanRequest struct {
+	ID               string     `json:"id"`
+	BroadcasterID    string     `json:"broadcaster_id"`
+	BroadcasterLogin string     `json:"broadcaster_login"`
+	BroadcasterName  string     `json:"broadcaster_name"`
+	ModeratorID      string     `json:"moderator_id"`
+	ModeratorLogin   string     `json:"moderator_login"`
+	ModeratorName    string     `json:"moderator_name"`
+	UserID           string     `json:"user_id"`
+	UserLogin        string     `json:"user_login"`
+	UserName         string     `json:"user_name"`
+	Text             string     `json:"text"`
+	Status           string     `json:"status"`
+	CreatedAt        time.Time  `json:"created_at"`
+	ResolvedAt       *time.Time `json:"resolved_at"`
+	ResolutionText   string     `json:"resolution_text"`
+}
+
+// ShieldModeStatus represents whether shield mode is on in a channel and
+// who last turned it on.
+type ShieldModeStatus struct {
+	IsActive        bool   `json:"is_active"`
+	ModeratorID     string `json:"moderator_id"`
+	ModeratorLogin  string `json:"moderator_login"`
+	ModeratorName   string `json:"moderator_name"`
+	LastActivatedAt string `json:"last_activated_at"`
+}
+
+// ChannelMember represents a user with a role in a channel, such as one
+// of its moderators or VIPs.
+type ChannelMember struct {
+	UserID    string `json:"user_id"`
+	UserLogin string `json:"user_login"`
+	UserName  string `json:"user_name"`
+}
+
+// Clip represents a clip that is being created. Twitch creates clips
+// asynchronously, so the clip may not be viewable straight away.
+type Clip struct {
+	ID      string `json:"id"`
+	EditURL string `json:"edit_url"`
+}
+
+// URL returns where the clip can be watched once it has been created.
+func (c Clip) URL() string {
+	return "https://clips.twitch.tv/" + c.ID
+}
+
+// StreamMarker represents a marker added to a live stream, PositionSeconds
+// into the broadcast.
+type StreamMarker struct {
+	ID              string    `json:"id"`
+	CreatedAt       time.Time `json:"created_at"`
+	Description     string    `json:"description"`
+	PositionSeconds int       `json:"position_seconds"`
+}
+
+// Emote represents a Twitch emote, typed in chat by its name. EmoteType is
+// empty for global emotes and Tier is only set for subscriber emotes.
+type Emote struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Tier       string   `json:"tier"`
+	EmoteType  string   `json:"emote_type"`
+	EmoteSetID string   `json:"emote_set_id"`
+	OwnerID    string   `json:"owner_id"`
+	Format     []string `json:"format"`
+	Scale      []string `json:"scale"`
+	ThemeMode  []string `json:"theme_mode"`
+}
+
+// BadgeSet represents a kind of chat badge, such as subscriber, and its
+// versions, such as the badges for each subscription length.
+type BadgeSet struct {
+	SetID    string         `json:"set_id"`
+	Versions []BadgeVersion `json:"versions"`
+}
+
+// BadgeVersion represents one version of a chat badge.
+type BadgeVersion struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ImageURL1x  string `json:"image_url_1x"`
+	ClickAction string `json:"click_action"`
+	ClickURL    string `json:"click_url"`
+}
+
 // Metadata represents the metadata in EventSub messages
 // - Welcome
 // - KeepAlive
@@ -37,18 +346,358 @@ type SubscriptionMetadata struct {
 	SubscriptionVersion string    `json:"subscription_version"`
 }
 
-// ChannelChatMessageSub represents a channel.chat.message subsription request
-func ChannelChatMessageSub(userID, sessionID string) map[string]any {
+// Notification represents an EventSub notification message. The event is
+// left undecoded until the subscription type is known.
+type Notification struct {
+	Metadata SubscriptionMetadata `json:"metadata"`
+	Payload  struct {
+		Subscription struct {
+			ID      string `json:"id"`
+			Type    string `json:"type"`
+			Version string `json:"version"`
+		} `json:"subscription"`
+		Event json.RawMessage `json:"event"`
+	} `json:"payload"`
+}
+
+// eventSub builds a websocket subscription request for a subscription
+// type, version and condition
+func eventSub(subType, version, sessionID string, condition map[string]any) map[string]any {
 	return map[string]any{
-		"type":    "channel.chat.message",
-		"version": "1",
-		"condition": map[string]any{
-			"broadcaster_user_id": userID,
-			"user_id":             userID,
-		},
+		"type":      subType,
+		"version":   version,
+		"condition": condition,
 		"transport": map[string]any{
 			"method":     "websocket",
 			"session_id": sessionID,
 		},
 	}
 }
+
+// ChannelChatMessageSub represents a channel.chat.message subsription request
+func ChannelChatMessageSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.chat.message", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"user_id":             userID,
+	})
+}
+
+// ChannelChatSettingsUpdateSub represents a channel.chat_settings.update subscription request
+func ChannelChatSettingsUpdateSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.chat_settings.update", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"user_id":             userID,
+	})
+}
+
+// ChannelChatNotificationSub represents a channel.chat.notification subscription request
+func ChannelChatNotificationSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.chat.notification", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"user_id":             userID,
+	})
+}
+
+// ChannelShoutoutCreateSub represents a channel.shoutout.create subscription request
+func ChannelShoutoutCreateSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.shoutout.create", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// ChannelShoutoutReceiveSub represents a channel.shoutout.receive subscription request
+func ChannelShoutoutReceiveSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.shoutout.receive", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// UserWhisperMessageSub represents a user.whisper.message subscription request
+func UserWhisperMessageSub(userID, sessionID string) map[string]any {
+	return eventSub("user.whisper.message", "1", sessionID, map[string]any{
+		"user_id": userID,
+	})
+}
+
+// StreamOnlineSub represents a stream.online subscription request
+func StreamOnlineSub(userID, sessionID string) map[string]any {
+	return eventSub("stream.online", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// StreamOfflineSub represents a stream.offline subscription request
+func StreamOfflineSub(userID, sessionID string) map[string]any {
+	return eventSub("stream.offline", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelUpdateSub represents a channel.update subscription request
+func ChannelUpdateSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.update", "2", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelFollowSub represents a channel.follow subscription request
+func ChannelFollowSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.follow", "2", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// ChannelSubscribeSub represents a channel.subscribe subscription request
+func ChannelSubscribeSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.subscribe", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelSubscriptionGiftSub represents a channel.subscription.gift subscription request
+func ChannelSubscriptionGiftSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.subscription.gift", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelSubscriptionMessageSub represents a channel.subscription.message subscription request
+func ChannelSubscriptionMessageSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.subscription.message", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelCheerSub represents a channel.cheer subscription request
+func ChannelCheerSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.cheer", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelPointsRedemptionAddSub represents a channel.channel_points_custom_reward_redemption.add subscription request
+func ChannelPointsRedemptionAddSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.channel_points_custom_reward_redemption.add", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelPollBeginSub represents a channel.poll.begin subscription request
+func ChannelPollBeginSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.poll.begin", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelPollProgressSub represents a channel.poll.progress subscription request
+func ChannelPollProgressSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.poll.progress", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelPollEndSub represents a channel.poll.end subscription request
+func ChannelPollEndSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.poll.end", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelPredictionBeginSub represents a channel.prediction.begin subscription request
+func ChannelPredictionBeginSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.prediction.begin", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelPredictionProgressSub represents a channel.prediction.progress subscription request
+func ChannelPredictionProgressSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.prediction.progress", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelPredictionLockSub represents a channel.prediction.lock subscription request
+func ChannelPredictionLockSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.prediction.lock", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelPredictionEndSub represents a channel.prediction.end subscription request
+func ChannelPredictionEndSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.prediction.end", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelRaidSub represents a channel.raid subscription request for raids
+// into the user's channel
+func ChannelRaidSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.raid", "1", sessionID, map[string]any{
+		"to_broadcaster_user_id": userID,
+	})
+}
+
+// ChannelHypeTrainBeginSub represents a channel.hype_train.begin subscription request
+func ChannelHypeTrainBeginSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.hype_train.begin", "2", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelHypeTrainProgressSub represents a channel.hype_train.progress subscription request
+func ChannelHypeTrainProgressSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.hype_train.progress", "2", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelHypeTrainEndSub represents a channel.hype_train.end subscription request
+func ChannelHypeTrainEndSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.hype_train.end", "2", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelAdBreakBeginSub represents a channel.ad_break.begin subscription request
+func ChannelAdBreakBeginSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.ad_break.begin", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelModerateSub represents a channel.moderate subscription request
+func ChannelModerateSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.moderate", "2", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// ChannelChatClearUserMessagesSub represents a channel.chat.clear_user_messages subscription request
+func ChannelChatClearUserMessagesSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.chat.clear_user_messages", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"user_id":             userID,
+	})
+}
+
+// ChannelChatMessageDeleteSub represents a channel.chat.message_delete subscription request
+func ChannelChatMessageDeleteSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.chat.message_delete", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"user_id":             userID,
+	})
+}
+
+// AutoModMessageHoldSub represents an automod.message.hold subscription request
+func AutoModMessageHoldSub(userID, sessionID string) map[string]any {
+	return eventSub("automod.message.hold", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// AutoModMessageUpdateSub represents an automod.message.update subscription request
+func AutoModMessageUpdateSub(userID, sessionID string) map[string]any {
+	return eventSub("automod.message.update", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// ChannelUnbanRequestCreateSub represents a channel.unban_request.create subscription request
+func ChannelUnbanRequestCreateSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.unban_request.create", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// ChannelUnbanRequestResolveSub represents a channel.unban_request.resolve subscription request
+func ChannelUnbanRequestResolveSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.unban_request.resolve", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// ChannelShieldModeBeginSub represents a channel.shield_mode.begin subscription request
+func ChannelShieldModeBeginSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.shield_mode.begin", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// ChannelShieldModeEndSub represents a channel.shield_mode.end subscription request
+func ChannelShieldModeEndSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.shield_mode.end", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// ChannelSuspiciousUserMessageSub represents a channel.suspicious_user.message subscription request
+func ChannelSuspiciousUserMessageSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.suspicious_user.message", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// ChannelSuspiciousUserUpdateSub represents a channel.suspicious_user.update subscription request
+func ChannelSuspiciousUserUpdateSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.suspicious_user.update", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// ChannelWarningSendSub represents a channel.warning.send subscription request
+func ChannelWarningSendSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.warning.send", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// ChannelWarningAcknowledgeSub represents a channel.warning.acknowledge subscription request
+func ChannelWarningAcknowledgeSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.warning.acknowledge", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+		"moderator_user_id":   userID,
+	})
+}
+
+// ChannelModeratorAddSub represents a channel.moderator.add subscription request
+func ChannelModeratorAddSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.moderator.add", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelModeratorRemoveSub represents a channel.moderator.remove subscription request
+func ChannelModeratorRemoveSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.moderator.remove", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelVIPAddSub represents a channel.vip.add subscription request
+func ChannelVIPAddSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.vip.add", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
+
+// ChannelVIPRemoveSub represents a channel.vip.remove subscription request
+func ChannelVIPRemoveSub(userID, sessionID string) map[string]any {
+	return eventSub("channel.vip.remove", "1", sessionID, map[string]any{
+		"broadcaster_user_id": userID,
+	})
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// BanUser bans userID from the broadcaster's chat. A duration in seconds
+// greater than zero times the user out instead of banning them permanently.
+func BanUser(client *http.Client, accessToken, broadcasterID, moderatorID, userID string, duration int, reason string) error {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+
+	data := map[string]any{
+		"user_id": userID,
+	}
+	if duration > 0 {
+		data["duration"] = duration
+	}
+	if reason != "" {
+		data["reason"] = reason
+	}
+
+	status, body, err := doHelixRequest(client, "POST", twitchBansURL+"?"+q.Encode(), accessToken, map[string]any{"data": data})
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
+
+// UnbanUser lifts a ban or timeout on userID in the broadcaster's chat.
+func UnbanUser(client *http.Client, accessToken, broadcasterID, moderatorID, userID string) error {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+	q.Set("user_id", userID)
+
+	status, body, err := doHelixRequest(client, "DELETE", twitchBansURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNoContent {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
+
+// DeleteChatMessages removes a single message from the broadcaster's chat.
+// An empty messageID clears every message from the chat.
+func DeleteChatMessages(client *http.Client, accessToken, broadcasterID, moderatorID, messageID string) error {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+	if messageID != "" {
+		q.Set("message_id", messageID)
+	}
+
+	status, body, err := doHelixRequest(client, "DELETE", twitchModerationChatURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNoContent {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
+
+// GetUnbanRequests lists a page of up to 100 of the broadcaster's unban
+// requests with the given status, such as pending, starting after the
+// cursor of the previous page. The returned cursor is empty on the last page.
+func GetUnbanRequests(client *http.Client, accessToken, broadcasterID, moderatorID, requestStatus, after string) ([]UnbanRequest, string, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+	q.Set("status", requestStatus)
+	q.Set("first", "100")
+	if after != "" {
+		q.Set("after", after)
+	}
+
+	status, body, err := doHelixRequest(client, "GET", twitchUnbanRequestsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if status != http.StatusOK {
+		return nil, "", helixStatusError(status, body)
+	}
+	return decodePage[UnbanRequest](body)
+}
+
+// ResolveUnbanRequest approves or denies an unban request. requestStatus
+// is approved or denied, and resolutionText is an optional message shown
+// to the user.
+func ResolveUnbanRequest(client *http.Client, accessToken, broadcasterID, moderatorID, requestID, requestStatus, resolutionText string) (*UnbanRequest, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+	q.Set("unban_request_id", requestID)
+	q.Set("status", requestStatus)
+	if resolutionText != "" {
+		q.Set("resolution_text", resolutionText)
+	}
+
+	status, body, err := doHelixRequest(client, "PATCH", twitchUnbanRequestsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	requests, err := decodeData[UnbanRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no unban request returned")
+	}
+	return &requests[0], nil
+}
+
+// GetShieldModeStatus retrieves whether shield mode is on in the
+// broadcaster's chat.
+func GetShieldModeStatus(client *http.Client, accessToken, broadcasterID, moderatorID string) (*ShieldModeStatus, error) {
+	return shieldModeRequest(client, "GET", accessToken, broadcasterID, moderatorID, nil)
+}
+
+// UpdateShieldModeStatus turns shield mode on or off in the broadcaster's chat.
+func UpdateShieldModeStatus(client *http.Client, accessToken, broadcasterID, moderatorID string, active bool) (*ShieldModeStatus, error) {
+	return shieldModeRequest(client, "PUT", accessToken, broadcasterID, moderatorID, map[string]any{"is_active": active})
+}
+
+func shieldModeRequest(client *http.Client, method, accessToken, broadcasterID, moderatorID string, payload any) (*ShieldModeStatus, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+
+	status, body, err := doHelixRequest(client, method, twitchShieldModeURL+"?"+q.Encode(), accessToken, payload)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+
+	statuses, err := decodeData[ShieldModeStatus](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no shield mode status returned")
+	}
+	return &statuses[0], nil
+}
+
+// WarnChatUser warns userID in the broadcaster's chat. The user must
+// acknowledge the warning before they can chat again.
+func WarnChatUser(client *http.Client, accessToken, broadcasterID, moderatorID, userID, reason string) error {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("moderator_id", moderatorID)
+
+	data := map[string]any{
+		"user_id": userID,
+		"reason":  reason,
+	}
+
+	status, body, err := doHelixRequest(client, "POST", twitchWarningsURL+"?"+q.Encode(), accessToken, map[string]any{"data": data})
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
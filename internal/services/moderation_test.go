@@ -0,0 +1,333 @@
+package services
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// decodeRequestBody reads a JSON request body into a generic map.
+func decodeRequestBody(req *http.Request) map[string]any {
+	var body map[string]any
+	b, _ := io.ReadAll(req.Body)
+	_ = json.Unmarshal(b, &body)
+	return body
+}
+
+func TestBanUser(t *testing.T) {
+	tests := []struct {
+		name         string
+		duration     int
+		respCode     int
+		respBody     string
+		wantDuration any
+		wantErr      bool
+		errContains  string
+	}{
+		{
+			name:         "200 OK - ban",
+			respCode:     http.StatusOK,
+			respBody:     `{"data": [{"broadcaster_id": "123", "moderator_id": "123", "user_id": "999", "end_time": null}]}`,
+			wantDuration: nil,
+		},
+		{
+			name:         "200 OK - timeout",
+			duration:     600,
+			respCode:     http.StatusOK,
+			respBody:     `{"data": [{"broadcaster_id": "123", "moderator_id": "123", "user_id": "999"}]}`,
+			wantDuration: float64(600),
+		},
+		{
+			name:        "400 Bad Request - already banned",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"The user specified in the user_id field is already banned."}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				data, _ := decodeRequestBody(req)["data"].(map[string]any)
+				return req.Method == "POST" &&
+					req.URL.Query().Get("moderator_id") == "123" &&
+					data["user_id"] == "999" &&
+					data["duration"] == tt.wantDuration
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			err := BanUser(client, "token", "123", "123", "999", tt.duration, "")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUnbanUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{name: "204 No Content", respCode: http.StatusNoContent},
+		{
+			name:        "400 Bad Request - not banned",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"The user specified in the user_id field is not banned."}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+		{
+			name:        "403 Forbidden - not a moderator",
+			respCode:    http.StatusForbidden,
+			respBody:    `{"error":"The user in moderator_id is not one of the broadcaster's moderators."}`,
+			wantErr:     true,
+			errContains: "forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "DELETE" && req.URL.Query().Get("user_id") == "999"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			err := UnbanUser(client, "token", "123", "123", "999")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteChatMessages(t *testing.T) {
+	tests := []struct {
+		name      string
+		messageID string
+		respCode  int
+		respBody  string
+		wantErr   bool
+	}{
+		{name: "204 No Content - single message", messageID: "abc-123", respCode: http.StatusNoContent},
+		{name: "204 No Content - clear chat", respCode: http.StatusNoContent},
+		{
+			name:      "404 Not Found - message too old",
+			messageID: "abc-123",
+			respCode:  http.StatusNotFound,
+			respBody:  `{"error":"The ID in message_id was not found."}`,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				_, hasMessageID := req.URL.Query()["message_id"]
+				return req.Method == "DELETE" &&
+					hasMessageID == (tt.messageID != "") &&
+					req.URL.Query().Get("message_id") == tt.messageID
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			err := DeleteChatMessages(client, "token", "123", "123", tt.messageID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetUnbanRequests(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		q := req.URL.Query()
+		return req.Method == "GET" && q.Get("status") == "pending" && q.Get("moderator_id") == "141981764"
+	})).Return(makeResp(http.StatusOK, `{"data": [{"id": "92af127c-7326-4483-a52b-b0da0be61c01", "broadcaster_id": "274637212", "moderator_id": null, "user_id": "1234", "user_login": "banneduser", "user_name": "BannedUser", "text": "Please unban me", "status": "pending", "created_at": "2022-08-07T02:07:55Z", "resolved_at": null, "resolution_text": null}], "pagination": {"cursor": "eyJiIjpudWxsLCJhIjp7IkN1cnNvciI6I"}}`), nil)
+	client := buildMockClient(mockRT)
+
+	requests, cursor, err := GetUnbanRequests(client, "token", "274637212", "141981764", "pending", "")
+
+	assert.NoError(t, err)
+	assert.Len(t, requests, 1)
+	assert.Equal(t, "Please unban me", requests[0].Text)
+	assert.Equal(t, "eyJiIjpudWxsLCJhIjp7IkN1cnNvciI6I", cursor)
+	mockRT.AssertExpectations(t)
+}
+
+func TestResolveUnbanRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"id": "92af127c-7326-4483-a52b-b0da0be61c01", "broadcaster_id": "274637212", "moderator_id": "141981764", "user_id": "1234", "user_login": "banneduser", "user_name": "BannedUser", "text": "Please unban me", "status": "approved", "created_at": "2022-08-07T02:07:55Z", "resolved_at": "2022-08-09T02:07:55Z", "resolution_text": "Welcome back"}]}`,
+		},
+		{
+			name:        "400 Bad Request - already resolved",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"Bad Request","status":400,"message":"The unban request is already resolved."}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				q := req.URL.Query()
+				return req.Method == "PATCH" && q.Get("unban_request_id") == "92af127c-7326-4483-a52b-b0da0be61c01" &&
+					q.Get("status") == "approved" && q.Get("resolution_text") == "Welcome back"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			request, err := ResolveUnbanRequest(client, "token", "274637212", "141981764", "92af127c-7326-4483-a52b-b0da0be61c01", "approved", "Welcome back")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "approved", request.Status)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetShieldModeStatus(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "GET" && req.URL.Query().Get("moderator_id") == "98765"
+	})).Return(makeResp(http.StatusOK, `{"data": [{"is_active": true, "moderator_id": "98765", "moderator_name": "SimplySimple", "moderator_login": "simplysimple", "last_activated_at": "2022-07-26T17:16:03.123Z"}]}`), nil)
+	client := buildMockClient(mockRT)
+
+	status, err := GetShieldModeStatus(client, "token", "12345", "98765")
+
+	assert.NoError(t, err)
+	assert.True(t, status.IsActive)
+	assert.Equal(t, "SimplySimple", status.ModeratorName)
+	mockRT.AssertExpectations(t)
+}
+
+func TestUpdateShieldModeStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		active      bool
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK - activate",
+			active:   true,
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"is_active": true, "moderator_id": "98765", "moderator_name": "SimplySimple", "moderator_login": "simplysimple", "last_activated_at": "2022-07-26T17:16:03.123Z"}]}`,
+		},
+		{
+			name:        "403 Forbidden - not a moderator",
+			active:      false,
+			respCode:    http.StatusForbidden,
+			respBody:    `{"error":"Forbidden","status":403,"message":"The user is not one of the broadcaster's moderators."}`,
+			wantErr:     true,
+			errContains: "forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "PUT" && decodeRequestBody(req)["is_active"] == tt.active
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			status, err := UpdateShieldModeStatus(client, "token", "12345", "98765", tt.active)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, status.IsActive)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWarnChatUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: `{"data": [{"broadcaster_id": "404040", "user_id": "9876", "moderator_id": "404041", "reason": "stop doing that!"}]}`,
+		},
+		{
+			name:        "409 Conflict - warning being updated",
+			respCode:    http.StatusConflict,
+			respBody:    `{"error":"Conflict","status":409,"message":"The user is already being warned."}`,
+			wantErr:     true,
+			errContains: "conflict",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				data, _ := decodeRequestBody(req)["data"].(map[string]any)
+				return req.Method == "POST" && data["user_id"] == "9876" && data["reason"] == "stop doing that!"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			err := WarnChatUser(client, "token", "404040", "404041", "9876", "stop doing that!")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CreatePoll starts a poll in the broadcaster's channel that runs for
+// duration seconds.
+func CreatePoll(client *http.Client, accessToken, broadcasterID, title string, choices []string, duration int) (*Poll, error) {
+	pollChoices := make([]map[string]any, len(choices))
+	for i, choice := range choices {
+		pollChoices[i] = map[string]any{"title": choice}
+	}
+	payload := map[string]any{
+		"broadcaster_id": broadcasterID,
+		"title":          title,
+		"choices":        pollChoices,
+		"duration":       duration,
+	}
+
+	status, body, err := doHelixRequest(client, "POST", twitchPollsURL, accessToken, payload)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+	return firstPoll(body)
+}
+
+// GetPolls lists the broadcaster's polls from the last 90 days, most
+// recent first.
+func GetPolls(client *http.Client, accessToken, broadcasterID string) ([]Poll, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+
+	status, body, err := doHelixRequest(client, "GET", twitchPollsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+	return decodeData[Poll](body)
+}
+
+// EndPoll ends an active poll. With status TERMINATED the results stay
+// visible to viewers, with ARCHIVED they are hidden.
+func EndPoll(client *http.Client, accessToken, broadcasterID, pollID, pollStatus string) (*Poll, error) {
+	payload := map[string]any{
+		"broadcaster_id": broadcasterID,
+		"id":             pollID,
+		"status":         pollStatus,
+	}
+
+	status, body, err := doHelixRequest(client, "PATCH", twitchPollsURL, accessToken, payload)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+	return firstPoll(body)
+}
+
+func firstPoll(body []byte) (*Poll, error) {
+	polls, err := decodeData[Poll](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(polls) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no poll returned")
+	}
+	return &polls[0], nil
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const pollResponse = `{"data": [{
+	"id": "ed961efd-8a3f-4cf5-a9d0-e616c590cd2a",
+	"broadcaster_id": "141981764",
+	"title": "Heads or Tails?",
+	"choices": [
+		{"id": "4c123012-1351-4f33-84b7-43856e7a0f47", "title": "Heads", "votes": 3, "channel_points_votes": 0, "bits_votes": 0},
+		{"id": "279087e3-54a7-467e-bcd0-c1393fcea4f0", "title": "Tails", "votes": 5, "channel_points_votes": 0, "bits_votes": 0}
+	],
+	"channel_points_voting_enabled": false,
+	"channel_points_per_vote": 0,
+	"status": "ACTIVE",
+	"duration": 1800,
+	"started_at": "2021-03-19T06:08:33.871278372Z"
+}]}`
+
+func TestCreatePoll(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: pollResponse,
+		},
+		{
+			name:        "400 Bad Request - duration out of range",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"Bad Request","status":400,"message":"The duration must be between 15 and 1800 seconds."}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				body := decodeRequestBody(req)
+				choices, _ := body["choices"].([]any)
+				return req.Method == "POST" && body["title"] == "Heads or Tails?" && len(choices) == 2 && body["duration"] == float64(1800)
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			poll, err := CreatePoll(client, "token", "141981764", "Heads or Tails?", []string{"Heads", "Tails"}, 1800)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "ed961efd-8a3f-4cf5-a9d0-e616c590cd2a", poll.ID)
+				assert.Len(t, poll.Choices, 2)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetPolls(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "GET" && req.URL.Query().Get("broadcaster_id") == "141981764"
+	})).Return(makeResp(http.StatusOK, pollResponse), nil)
+	client := buildMockClient(mockRT)
+
+	polls, err := GetPolls(client, "token", "141981764")
+
+	assert.NoError(t, err)
+	assert.Len(t, polls, 1)
+	assert.Equal(t, 5, polls[0].Choices[1].Votes)
+	mockRT.AssertExpectations(t)
+}
+
+func TestEndPoll(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: pollResponse,
+		},
+		{
+			name:        "400 Bad Request - poll not active",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"Bad Request","status":400,"message":"The poll is not active."}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				body := decodeRequestBody(req)
+				return req.Method == "PATCH" && body["id"] == "ed961efd-8a3f-4cf5-a9d0-e616c590cd2a" && body["status"] == "TERMINATED"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			_, err := EndPoll(client, "token", "141981764", "ed961efd-8a3f-4cf5-a9d0-e616c590cd2a", "TERMINATED")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CreatePrediction starts a prediction in the broadcaster's channel that
+// takes predictions for window seconds.
+func CreatePrediction(client *http.Client, accessToken, broadcasterID, title string, outcomes []string, window int) (*Prediction, error) {
+	predictionOutcomes := make([]map[string]any, len(outcomes))
+	for i, outcome := range outcomes {
+		predictionOutcomes[i] = map[string]any{"title": outcome}
+	}
+	payload := map[string]any{
+		"broadcaster_id":    broadcasterID,
+		"title":             title,
+		"outcomes":          predictionOutcomes,
+		"prediction_window": window,
+	}
+
+	status, body, err := doHelixRequest(client, "POST", twitchPredictionsURL, accessToken, payload)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+	return firstPrediction(body)
+}
+
+// GetPredictions lists the broadcaster's predictions from the last 90
+// days, most recent first.
+func GetPredictions(client *http.Client, accessToken, broadcasterID string) ([]Prediction, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+
+	status, body, err := doHelixRequest(client, "GET", twitchPredictionsURL+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+	return decodeData[Prediction](body)
+}
+
+// EndPrediction locks, resolves or cancels a prediction. status is
+// LOCKED, RESOLVED or CANCELED, and winningOutcomeID is only sent when
+// resolving. Canceling refunds every viewer's channel points.
+func EndPrediction(client *http.Client, accessToken, broadcasterID, predictionID, predictionStatus, winningOutcomeID string) (*Prediction, error) {
+	payload := map[string]any{
+		"broadcaster_id": broadcasterID,
+		"id":             predictionID,
+		"status":         predictionStatus,
+	}
+	if winningOutcomeID != "" {
+		payload["winning_outcome_id"] = winningOutcomeID
+	}
+
+	status, body, err := doHelixRequest(client, "PATCH", twitchPredictionsURL, accessToken, payload)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, helixStatusError(status, body)
+	}
+	return firstPrediction(body)
+}
+
+func firstPrediction(body []byte) (*Prediction, error) {
+	predictions, err := decodeData[Prediction](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(predictions) == 0 {
+		return nil, fmt.Errorf("twitch API 200 OK but no prediction returned")
+	}
+	return &predictions[0], nil
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const predictionResponse = `{"data": [{
+	"id": "bc637af0-7766-4525-9308-4112f4cbf178",
+	"broadcaster_id": "141981764",
+	"title": "Will there be any leaks today?",
+	"winning_outcome_id": null,
+	"outcomes": [
+		{"id": "73085848-a94d-4040-9d21-2cb7a89374b7", "title": "Yes, give it time.", "users": 2, "channel_points": 1500, "top_predictors": [{"user_id": "1234", "user_login": "viewer", "user_name": "Viewer", "channel_points_used": 1000, "channel_points_won": null}], "color": "BLUE"},
+		{"id": "906b70ba-1f12-47ea-9e95-e5f93d20e9cc", "title": "Definitely not.", "users": 0, "channel_points": 0, "top_predictors": null, "color": "PINK"}
+	],
+	"prediction_window": 600,
+	"status": "ACTIVE",
+	"created_at": "2021-04-28T16:03:06.320848689Z",
+	"ended_at": null,
+	"locked_at": null
+}]}`
+
+func TestCreatePrediction(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "200 OK",
+			respCode: http.StatusOK,
+			respBody: predictionResponse,
+		},
+		{
+			name:        "400 Bad Request - prediction already active",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"Bad Request","status":400,"message":"The broadcaster already has an active prediction."}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				body := decodeRequestBody(req)
+				outcomes, _ := body["outcomes"].([]any)
+				return req.Method == "POST" && len(outcomes) == 2 && body["prediction_window"] == float64(600)
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			prediction, err := CreatePrediction(client, "token", "141981764", "Will there be any leaks today?", []string{"Yes, give it time.", "Definitely not."}, 600)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1500, prediction.Outcomes[0].ChannelPoints)
+				assert.Equal(t, "Viewer", prediction.Outcomes[0].TopPredictors[0].UserName)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetPredictions(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "GET" && req.URL.Query().Get("broadcaster_id") == "141981764"
+	})).Return(makeResp(http.StatusOK, predictionResponse), nil)
+	client := buildMockClient(mockRT)
+
+	predictions, err := GetPredictions(client, "token", "141981764")
+
+	assert.NoError(t, err)
+	assert.Len(t, predictions, 1)
+	assert.Equal(t, "ACTIVE", predictions[0].Status)
+	mockRT.AssertExpectations(t)
+}
+
+func TestEndPrediction(t *testing.T) {
+	tests := []struct {
+		name             string
+		status           string
+		winningOutcomeID string
+	}{
+		{name: "lock", status: "LOCKED"},
+		{name: "resolve", status: "RESOLVED", winningOutcomeID: "73085848-a94d-4040-9d21-2cb7a89374b7"},
+		{name: "cancel", status: "CANCELED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				body := decodeRequestBody(req)
+				winner, hasWinner := body["winning_outcome_id"]
+				return req.Method == "PATCH" && body["status"] == tt.status &&
+					hasWinner == (tt.winningOutcomeID != "") && (!hasWinner || winner == tt.winningOutcomeID)
+			})).Return(makeResp(http.StatusOK, predictionResponse), nil)
+			client := buildMockClient(mockRT)
+
+			_, err := EndPrediction(client, "token", "141981764", "bc637af0-7766-4525-9308-4112f4cbf178", tt.status, tt.winningOutcomeID)
+
+			assert.NoError(t, err)
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// GetModerators lists a page of up to 100 of the broadcaster's moderators,
+// starting after the cursor of the previous page. The returned cursor is
+// empty on the last page.
+func GetModerators(client *http.Client, accessToken, broadcasterID, after string) ([]ChannelMember, string, error) {
+	return getChannelMembers(client, twitchModeratorsURL, accessToken, broadcasterID, after)
+}
+
+// AddChannelModerator makes userID a moderator of the broadcaster's channel.
+func AddChannelModerator(client *http.Client, accessToken, broadcasterID, userID string) error {
+	return changeChannelMember(client, "POST", twitchModeratorsURL, accessToken, broadcasterID, userID)
+}
+
+// RemoveChannelModerator removes userID from the broadcaster's moderators.
+func RemoveChannelModerator(client *http.Client, accessToken, broadcasterID, userID string) error {
+	return changeChannelMember(client, "DELETE", twitchModeratorsURL, accessToken, broadcasterID, userID)
+}
+
+// GetVIPs lists a page of up to 100 of the broadcaster's VIPs, starting
+// after the cursor of the previous page. The returned cursor is empty on
+// the last page.
+func GetVIPs(client *http.Client, accessToken, broadcasterID, after string) ([]ChannelMember, string, error) {
+	return getChannelMembers(client, twitchVIPsURL, accessToken, broadcasterID, after)
+}
+
+// AddChannelVIP makes userID a VIP of the broadcaster's channel.
+func AddChannelVIP(client *http.Client, accessToken, broadcasterID, userID string) error {
+	return changeChannelMember(client, "POST", twitchVIPsURL, accessToken, broadcasterID, userID)
+}
+
+// RemoveChannelVIP removes userID from the broadcaster's VIPs.
+func RemoveChannelVIP(client *http.Client, accessToken, broadcasterID, userID string) error {
+	return changeChannelMember(client, "DELETE", twitchVIPsURL, accessToken, broadcasterID, userID)
+}
+
+func getChannelMembers(client *http.Client, endpoint, accessToken, broadcasterID, after string) ([]ChannelMember, string, error) {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("first", "100")
+	if after != "" {
+		q.Set("after", after)
+	}
+
+	status, body, err := doHelixRequest(client, "GET", endpoint+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if status != http.StatusOK {
+		return nil, "", helixStatusError(status, body)
+	}
+	return decodePage[ChannelMember](body)
+}
+
+func changeChannelMember(client *http.Client, method, endpoint, accessToken, broadcasterID, userID string) error {
+	q := url.Values{}
+	q.Set("broadcaster_id", broadcasterID)
+	q.Set("user_id", userID)
+
+	status, body, err := doHelixRequest(client, method, endpoint+"?"+q.Encode(), accessToken, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNoContent {
+		return helixStatusError(status, body)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetModerators(t *testing.T) {
+	tests := []struct {
+		name        string
+		after       string
+		respCode    int
+		respBody    string
+		wantUsers   int
+		wantCursor  string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:       "first page",
+			respCode:   http.StatusOK,
+			respBody:   `{"data": [{"user_id": "424596340", "user_login": "quotrok", "user_name": "quotrok"}, {"user_id": "141981764", "user_login": "twitchdev", "user_name": "TwitchDev"}], "pagination": {"cursor": "eyJiIjpudWxsLCJhIjp7IkN1cnNvciI6IjEwMDQ3MzA2NDo4NjQwNjU3MToxSVZCVDFKMnY5M1BTOXh3d1E0dUdXMkJOMFcifX0"}}`,
+			wantUsers:  2,
+			wantCursor: "eyJiIjpudWxsLCJhIjp7IkN1cnNvciI6IjEwMDQ3MzA2NDo4NjQwNjU3MToxSVZCVDFKMnY5M1BTOXh3d1E0dUdXMkJOMFcifX0",
+		},
+		{
+			name:      "last page",
+			after:     "eyJiIjpudWxsLCJhIjp7IkN1cnNvciI6IjEwMDQ3MzA2NDo4NjQwNjU3MToxSVZCVDFKMnY5M1BTOXh3d1E0dUdXMkJOMFcifX0",
+			respCode:  http.StatusOK,
+			respBody:  `{"data": [], "pagination": {}}`,
+			wantUsers: 0,
+		},
+		{
+			name:        "401 Unauthorized - missing scope",
+			respCode:    http.StatusUnauthorized,
+			respBody:    `{"error":"Unauthorized","status":401,"message":"Missing scope: moderation:read or channel:manage:moderators"}`,
+			wantErr:     true,
+			errContains: "unauthorized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "GET" && req.URL.Path == "/helix/moderation/moderators" && req.URL.Query().Get("after") == tt.after
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			users, cursor, err := GetModerators(client, "token", "1234", tt.after)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, users, tt.wantUsers)
+				assert.Equal(t, tt.wantCursor, cursor)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetVIPs(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "GET" && req.URL.Path == "/helix/channels/vips" && req.URL.Query().Get("broadcaster_id") == "1234"
+	})).Return(makeResp(http.StatusOK, `{"data": [{"user_id": "11111", "user_name": "UserDisplayName", "user_login": "userloginname"}], "pagination": {}}`), nil)
+	client := buildMockClient(mockRT)
+
+	users, cursor, err := GetVIPs(client, "token", "1234", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []ChannelMember{{UserID: "11111", UserLogin: "userloginname", UserName: "UserDisplayName"}}, users)
+	assert.Empty(t, cursor)
+	mockRT.AssertExpectations(t)
+}
+
+func TestAddChannelModerator(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "204 No Content",
+			respCode: http.StatusNoContent,
+		},
+		{
+			name:        "400 Bad Request - already a moderator",
+			respCode:    http.StatusBadRequest,
+			respBody:    `{"error":"Bad Request","status":400,"message":"user is already a mod"}`,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "POST" && req.URL.Path == "/helix/moderation/moderators" && req.URL.Query().Get("user_id") == "5678"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			err := AddChannelModerator(client, "token", "1234", "5678")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRemoveChannelModerator(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "DELETE" && req.URL.Path == "/helix/moderation/moderators" && req.URL.Query().Get("user_id") == "5678"
+	})).Return(makeResp(http.StatusNoContent, ""), nil)
+	client := buildMockClient(mockRT)
+
+	err := RemoveChannelModerator(client, "token", "1234", "5678")
+
+	assert.NoError(t, err)
+	mockRT.AssertExpectations(t)
+}
+
+func TestAddChannelVIP(t *testing.T) {
+	tests := []struct {
+		name        string
+		respCode    int
+		respBody    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "204 No Content",
+			respCode: http.StatusNoContent,
+		},
+		{
+			name:        "409 Conflict - no VIP slots left",
+			respCode:    http.StatusConflict,
+			respBody:    `{"error":"Conflict","status":409,"message":"The broadcaster doesn't have available VIP slots"}`,
+			wantErr:     true,
+			errContains: "conflict",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRT := new(MockRoundTripper)
+			mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == "POST" && req.URL.Path == "/helix/channels/vips" && req.URL.Query().Get("user_id") == "5678"
+			})).Return(makeResp(tt.respCode, tt.respBody), nil)
+			client := buildMockClient(mockRT)
+
+			err := AddChannelVIP(client, "token", "1234", "5678")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRT.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRemoveChannelVIP(t *testing.T) {
+	mockRT := new(MockRoundTripper)
+	mockRT.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == "DELETE" && req.URL.Path == "/helix/channels/vips" && req.URL.Query().Get("user_id") == "5678"
+	})).Return(makeResp(http.StatusNoContent, ""), nil)
+	client := buildMockClient(mockRT)
+
+	err := RemoveChannelVIP(client, "token", "1234", "5678")
+
+	assert.NoError(t, err)
+	mockRT.AssertExpectations(t)
+}
@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// How long /commercial runs an ad for when no length is given, in seconds.
+const defaultCommercialSeconds = 30
+
+// Twitch's limits on a commercial's length, in seconds.
+const (
+	minCommercialSeconds = 30
+	maxCommercialSeconds = 180
+)
+
+// AdScheduleLoaded carries the channel's ad schedule read from the Twitch API.
+type AdScheduleLoaded struct {
+	schedule *services.AdSchedule
+	notice   string
+	err      error
+}
+
+// AdBreakEnded is sent when an ad break's countdown runs out.
+type AdBreakEnded struct{}
+
+func init() {
+	registerCommand(command{
+		name:  "commercial",
+		usage: "/commercial [seconds]",
+		help:  "Run an ad break of 30 to 180 seconds",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			length := defaultCommercialSeconds
+			if len(args) > 0 {
+				seconds, err := parseDurationSeconds(args[0])
+				if err != nil || seconds < minCommercialSeconds || seconds > maxCommercialSeconds {
+					return nil, errUsage
+				}
+				length = seconds
+			}
+			return func() tea.Msg {
+				commercial, err := services.StartCommercial(m.httpClient, m.accessToken, m.loggedInUser, length)
+				if err != nil {
+					return ActionDone{err: err}
+				}
+				notice := fmt.Sprintf("Running a %ds ad break", commercial.Length)
+				if commercial.Message != "" {
+					notice += ": " + commercial.Message
+				}
+				return ActionDone{notice: notice}
+			}, nil
+		},
+	})
+	registerCommand(command{
+		name:  "ads",
+		usage: "/ads",
+		help:  "Show when the next ad is due",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			return func() tea.Msg {
+				schedule, err := services.GetAdSchedule(m.httpClient, m.accessToken, m.loggedInUser)
+				if err != nil {
+					return AdScheduleLoaded{err: err}
+				}
+				return AdScheduleLoaded{schedule: schedule, notice: describeAdSchedule(schedule)}
+			}, nil
+		},
+	})
+	registerCommand(command{
+		name:  "snooze",
+		usage: "/snooze",
+		help:  "Push the next ad back by five minutes",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			return func() tea.Msg {
+				schedule, err := services.SnoozeNextAd(m.httpClient, m.accessToken, m.loggedInUser)
+				if err != nil {
+					return AdScheduleLoaded{err: err}
+				}
+				return AdScheduleLoaded{schedule: schedule, notice: "Next ad snoozed. " + describeAdSchedule(schedule)}
+			}, nil
+		},
+	})
+}
+
+func (m *ChatModel) loadAdScheduleCmd() tea.Cmd {
+	return func() tea.Msg {
+		schedule, err := services.GetAdSchedule(m.httpClient, m.accessToken, m.loggedInUser)
+		return AdScheduleLoaded{schedule: schedule, err: err}
+	}
+}
+
+func (m *ChatModel) setAdSchedule(msg AdScheduleLoaded) {
+	if msg.err != nil {
+		m.addNotice(RenderError(msg.err.Error()))
+		return
+	}
+	m.stream.nextAdAt = parseAdTime(msg.schedule.NextAdAt)
+	if msg.notice != "" {
+		m.addNotice(Notice(msg.notice))
+	}
+}
+
+// adBreakBegan starts the ad break countdown in the stream header and
+// reloads the ad schedule once the break is over.
+func (m *ChatModel) adBreakBegan(event services.AdBreakBeginEvent) tea.Cmd {
+	duration := time.Duration(event.DurationSeconds) * time.Second
+	m.stream.adBreakEndsAt = event.StartedAt.Add(duration)
+
+	notice := fmt.Sprintf("A %ds ad break has started", event.DurationSeconds)
+	if event.IsAutomatic {
+		notice = fmt.Sprintf("A scheduled %ds ad break has started", event.DurationSeconds)
+	}
+	m.addNotice(Notice(notice))
+	return m.adBreakTickCmd()
+}
+
+func (m *ChatModel) adBreakTickCmd() tea.Cmd {
+	return tea.Tick(time.Until(m.stream.adBreakEndsAt), func(time.Time) tea.Msg { return AdBreakEnded{} })
+}
+
+func (m *ChatModel) adBreakEnded() tea.Cmd {
+	if m.stream.adBreakEndsAt.IsZero() {
+		return nil
+	}
+	// The tick can fire early, or a later ad break may have pushed the end back
+	if time.Now().Before(m.stream.adBreakEndsAt) {
+		return m.adBreakTickCmd()
+	}
+	m.stream.adBreakEndsAt = time.Time{}
+	m.addNotice(Notice("The ad break is over"))
+	return m.loadAdScheduleCmd()
+}
+
+// adHeader renders the ad break countdown, or how long until the next ad.
+func (m *ChatModel) adHeader() string {
+	if left := time.Until(m.stream.adBreakEndsAt); left > 0 {
+		return AdStyle.Render("AD " + formatUptime(left))
+	}
+	if left := time.Until(m.stream.nextAdAt); left > 0 {
+		return MutedStyle.Render("ad in " + formatUptime(left))
+	}
+	return ""
+}
+
+func describeAdSchedule(schedule *services.AdSchedule) string {
+	text := "No ad is scheduled."
+	if next := parseAdTime(schedule.NextAdAt); !next.IsZero() {
+		text = fmt.Sprintf("Next ad in %s.", formatUptime(max(0, time.Until(next))))
+	}
+	return text + fmt.Sprintf(" %d snoozes left.", schedule.SnoozeCount)
+}
+
+// parseAdTime reads a time from the ad schedule, which is empty when no
+// ad is scheduled.
+func parseAdTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,179 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// alertKind is a kind of channel event shown as a highlighted line in chat.
+type alertKind int
+
+const (
+	alertFollow alertKind = iota
+	alertSubscribe
+	alertGift
+	alertResub
+	alertRedemption
+	alertRaid
+	// alertChatNotification covers the system messages Twitch posts in
+	// chat itself that no other alert reports
+	alertChatNotification
+)
+
+// alertKinds lists the alert kinds in the order the toggles are shown.
+var alertKinds = []struct {
+	kind  alertKind
+	label string
+}{
+	{alertFollow, "Follows"},
+	{alertSubscribe, "Subscriptions"},
+	{alertGift, "Gift subs"},
+	{alertResub, "Resubs"},
+	{alertRedemption, "Redemptions"},
+	{alertRaid, "Raids"},
+	{alertChatNotification, "Chat notifications"},
+}
+
+// addAlert renders a highlighted line for an alert of a kind that is not hidden.
+func (m *ChatModel) addAlert(kind alertKind, line chatLine, text string) {
+	if m.hiddenAlerts[kind] {
+		return
+	}
+	line.text = text
+	line.sentAt = time.Now()
+	m.appendLine(line, AlertStyle.Render("★ "+text))
+}
+
+func (m *ChatModel) addFollowAlert(event services.FollowEvent) {
+	line := chatLine{userID: event.UserID, login: event.UserLogin, name: event.UserName}
+	m.addAlert(alertFollow, line, event.UserName+" followed!")
+}
+
+func (m *ChatModel) addSubscribeAlert(event services.SubscribeEvent) {
+	line := chatLine{userID: event.UserID, login: event.UserLogin, name: event.UserName}
+	text := fmt.Sprintf("%s subscribed at %s", event.UserName, formatTier(event.Tier))
+	if event.IsGift {
+		text = fmt.Sprintf("%s received a gifted %s sub", event.UserName, formatTier(event.Tier))
+	}
+	m.addAlert(alertSubscribe, line, text)
+}
+
+func (m *ChatModel) addGiftAlert(event services.SubscriptionGiftEvent) {
+	gifter := event.UserName
+	if event.IsAnonymous {
+		gifter = "An anonymous gifter"
+	}
+	text := fmt.Sprintf("%s gifted %d %s subs", gifter, event.Total, formatTier(event.Tier))
+	if event.CumulativeTotal != nil {
+		text += fmt.Sprintf(" (%d in the channel)", *event.CumulativeTotal)
+	}
+	line := chatLine{userID: event.UserID, login: event.UserLogin, name: event.UserName}
+	m.addAlert(alertGift, line, text)
+}
+
+func (m *ChatModel) addResubAlert(event services.SubscriptionMessageEvent) {
+	text := fmt.Sprintf("%s resubscribed at %s for %d months", event.UserName, formatTier(event.Tier), event.CumulativeMonths)
+	if event.StreakMonths != nil && *event.StreakMonths > 1 {
+		text += fmt.Sprintf(", %d in a row", *event.StreakMonths)
+	}
+	if event.Message.Text != "" {
+		text += ": " + event.Message.Text
+	}
+	line := chatLine{userID: event.UserID, login: event.UserLogin, name: event.UserName}
+	m.addAlert(alertResub, line, text)
+}
+
+// dedicatedNoticeTypes are the chat notifications also sent as events of
+// their own, which already have an alert.
+var dedicatedNoticeTypes = map[string]bool{
+	"sub":                true,
+	"resub":              true,
+	"sub_gift":           true,
+	"community_sub_gift": true,
+	"raid":               true,
+}
+
+// addChatNotificationAlert renders the system message Twitch posted in chat,
+// followed by anything the user wrote with it, unless another alert
+// reports the same event.
+func (m *ChatModel) addChatNotificationAlert(event services.ChatNotificationEvent) {
+	if dedicatedNoticeTypes[event.NoticeType] {
+		return
+	}
+	text := event.SystemMessage
+	if event.Message.Text != "" {
+		text += " " + event.Message.Text
+	}
+	line := chatLine{
+		messageID: event.MessageID,
+		userID:    event.ChatterUserID,
+		login:     event.ChatterUserLogin,
+		name:      event.ChatterUserName,
+	}
+	if event.ChatterIsAnonymous {
+		line = chatLine{}
+	}
+	m.addAlert(alertChatNotification, line, text)
+}
+
+// formatTier renders a subscription tier such as "1000" as "Tier 1",
+// and Prime subscriptions as "Prime".
+func formatTier(tier string) string {
+	if strings.EqualFold(tier, "prime") {
+		return "Prime"
+	}
+	return "Tier " + strings.TrimSuffix(tier, "000")
+}
+
+// alertsPanel toggles which kinds of alerts are shown in chat and what
+// happens when another channel raids.
+type alertsPanel struct{}
+
+func (p *alertsPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	switch key.String() {
+	case "s":
+		m.raid.shoutout = !m.raid.shoutout
+		return nil
+	case "p":
+		m.raid.protection = !m.raid.protection
+		return nil
+	}
+	for i, a := range alertKinds {
+		if key.String() == fmt.Sprintf("%d", i+1) {
+			if m.hiddenAlerts == nil {
+				m.hiddenAlerts = map[alertKind]bool{}
+			}
+			m.hiddenAlerts[a.kind] = !m.hiddenAlerts[a.kind]
+		}
+	}
+	return nil
+}
+
+func (p *alertsPanel) View(m *ChatModel, width, height int) string {
+	lines := []string{Header("Alerts"), ""}
+	for i, a := range alertKinds {
+		lines = append(lines, Field(fmt.Sprintf("%d %s", i+1, a.label), onOff(!m.hiddenAlerts[a.kind])))
+	}
+
+	protection := onOff(m.raid.protection)
+	if m.raid.protection {
+		protection += fmt.Sprintf(" (follower-only for %s)", humanizeDuration(raidProtectionDuration))
+	}
+	lines = append(lines, "", Header("Raids"), "",
+		Field("s Shout out raiders", onOff(m.raid.shoutout)),
+		Field("p Raid protection", protection),
+	)
+	return strings.Join(lines, "\n")
+}
+
+func (p *alertsPanel) Help() string {
+	return fmt.Sprintf("1-%d: toggle alert   s: raid shoutouts   p: raid protection   esc: close", len(alertKinds))
+}
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// announcementColors maps the Twitch announcement colors to how they are drawn.
+var announcementColors = map[string]string{
+	"primary": "#9146FF",
+	"blue":    "#00D6D6",
+	"green":   "#00DB84",
+	"orange":  "#FFB31A",
+	"purple":  "#9146FF",
+}
+
+func init() {
+	registerCommand(command{
+		name:  "announce",
+		usage: "/announce <message>",
+		help:  "Send a highlighted announcement",
+		run:   announceCommand(""),
+	})
+	for _, color := range []string{"blue", "green", "orange", "purple"} {
+		registerCommand(command{
+			name:  "announce" + color,
+			usage: "/announce" + color + " <message>",
+			help:  "Send a " + color + " announcement",
+			run:   announceCommand(color),
+		})
+	}
+	registerCommand(command{
+		name:  "shoutout",
+		usage: "/shoutout <channel>",
+		help:  "Recommend another channel to chat",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			return m.withUser(args[0], func(user services.UserInfo) tea.Cmd {
+				return m.shoutoutCmd(user.ID)
+			}), nil
+		},
+	})
+}
+
+func announceCommand(color string) func(m *ChatModel, args []string) (tea.Cmd, error) {
+	return func(m *ChatModel, args []string) (tea.Cmd, error) {
+		if len(args) == 0 {
+			return nil, errUsage
+		}
+		message := strings.Join(args, " ")
+		return func() tea.Msg {
+			err := services.SendChatAnnouncement(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, message, color)
+			return ActionDone{err: err}
+		}, nil
+	}
+}
+
+func (m *ChatModel) shoutoutCmd(userID string) tea.Cmd {
+	return func() tea.Msg {
+		err := services.SendShoutout(m.httpClient, m.accessToken, m.loggedInUser, userID, m.loggedInUser)
+		return ActionDone{err: err}
+	}
+}
+
+// addAnnouncement renders an announcement with a bar in its color.
+func (m *ChatModel) addAnnouncement(event services.ChatNotificationEvent) {
+	color := announcementColors["primary"]
+	if event.Announcement != nil {
+		if c, ok := announcementColors[strings.ToLower(event.Announcement.Color)]; ok {
+			color = c
+		}
+	}
+	style := lipgloss.NewStyle().Foreground(lipgloss.Color(color))
+	rendered := style.Render("▌ ") + style.Bold(true).Render("Announcement") + " " +
+		LabelStyle.Render(event.ChatterUserName+":") + " " + event.Message.Text
+
+	m.appendLine(chatLine{
+		messageID: event.MessageID,
+		userID:    event.ChatterUserID,
+		login:     event.ChatterUserLogin,
+		name:      event.ChatterUserName,
+		text:      event.Message.Text,
+		sentAt:    time.Now(),
+	}, rendered)
+}
+
+func (m *ChatModel) addShoutoutCreated(event services.ShoutoutCreateEvent) {
+	m.addNotice(ShoutoutStyle.Render(fmt.Sprintf("📣 %s gave %s a shoutout in front of %d viewers",
+		event.ModeratorUserName, event.ToBroadcasterUserName, event.ViewerCount)))
+}
+
+func (m *ChatModel) addShoutoutReceived(event services.ShoutoutReceiveEvent) {
+	m.addNotice(ShoutoutStyle.Render(fmt.Sprintf("📣 %s shouted you out to %d viewers",
+		event.FromBroadcasterUserName, event.ViewerCount)))
+}
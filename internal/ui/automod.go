@@ -0,0 +1,288 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// The highest AutoMod level, which filters the most.
+const maxAutoModLevel = 4
+
+// autoModCategories are the AutoMod categories in the order the settings
+// are shown, keyed by their Twitch API field.
+var autoModCategories = []struct {
+	field string
+	label string
+}{
+	{"disability", "Disability"},
+	{"aggression", "Aggression"},
+	{"sexuality_sex_or_gender", "Sexuality, sex or gender"},
+	{"misogyny", "Misogyny"},
+	{"bullying", "Bullying"},
+	{"swearing", "Swearing"},
+	{"race_ethnicity_or_religion", "Race, ethnicity or religion"},
+	{"sex_based_terms", "Sex-based terms"},
+}
+
+// HeldMessageResolved reports the outcome of allowing or denying a held message.
+type HeldMessageResolved struct {
+	message services.AutoModMessageEvent
+	action  string
+	err     error
+}
+
+// AutoModSettingsLoaded carries AutoMod settings read from or written to
+// the Twitch API.
+type AutoModSettingsLoaded struct {
+	settings *services.AutoModSettings
+	notice   string
+	err      error
+}
+
+// holdMessage queues a message AutoMod held for review.
+func (m *ChatModel) holdMessage(event services.AutoModMessageEvent) {
+	m.heldMessages = append(m.heldMessages, event)
+	m.addNotice(Notice(fmt.Sprintf("AutoMod held a message from %s (%s, level %d)", event.UserName, event.Category, event.Level)))
+}
+
+// heldMessageUpdated removes a held message once a moderator has allowed
+// or denied it, or it expired.
+func (m *ChatModel) heldMessageUpdated(event services.AutoModMessageEvent) {
+	if !m.removeHeldMessage(event.MessageID) {
+		return
+	}
+	notice := fmt.Sprintf("The held message from %s expired", event.UserName)
+	if event.ModeratorUserName != "" {
+		notice = fmt.Sprintf("%s %s the held message from %s", event.ModeratorUserName, strings.ToLower(event.Status), event.UserName)
+	}
+	m.addNotice(Notice(notice))
+}
+
+func (m *ChatModel) removeHeldMessage(messageID string) bool {
+	for i, held := range m.heldMessages {
+		if held.MessageID == messageID {
+			m.heldMessages = append(m.heldMessages[:i], m.heldMessages[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (m *ChatModel) resolveHeldMessageCmd(message services.AutoModMessageEvent, action string) tea.Cmd {
+	return func() tea.Msg {
+		err := services.ManageHeldAutoModMessage(m.httpClient, m.accessToken, m.loggedInUser, message.MessageID, action)
+		return HeldMessageResolved{message: message, action: action, err: err}
+	}
+}
+
+func (m *ChatModel) heldMessageResolved(msg HeldMessageResolved) {
+	if msg.err != nil {
+		m.addNotice(RenderError(msg.err.Error()))
+		return
+	}
+	m.removeHeldMessage(msg.message.MessageID)
+	verb := "Allowed"
+	if msg.action == "DENY" {
+		verb = "Denied"
+	}
+	m.addNotice(Notice(fmt.Sprintf("%s the held message from %s", verb, msg.message.UserName)))
+}
+
+func (m *ChatModel) loadAutoModSettingsCmd() tea.Cmd {
+	return func() tea.Msg {
+		settings, err := services.GetAutoModSettings(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser)
+		return AutoModSettingsLoaded{settings: settings, err: err}
+	}
+}
+
+func (m *ChatModel) updateAutoModSettingsCmd(settings map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		updated, err := services.UpdateAutoModSettings(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, settings)
+		return AutoModSettingsLoaded{settings: updated, notice: "AutoMod settings updated", err: err}
+	}
+}
+
+// autoModLevels reads the individual category levels from settings.
+func autoModLevels(s *services.AutoModSettings) map[string]int {
+	return map[string]int{
+		"disability":                 s.Disability,
+		"aggression":                 s.Aggression,
+		"sexuality_sex_or_gender":    s.SexualitySexOrGender,
+		"misogyny":                   s.Misogyny,
+		"bullying":                   s.Bullying,
+		"swearing":                   s.Swearing,
+		"race_ethnicity_or_religion": s.RaceEthnicityOrReligion,
+		"sex_based_terms":            s.SexBasedTerms,
+	}
+}
+
+// autoModPanel is the queue of messages AutoMod held for review, and can
+// switch to the AutoMod settings.
+type autoModPanel struct {
+	selected     int
+	showSettings bool
+
+	// The settings as last loaded, and the levels being edited. The
+	// overall level is nil when the levels are set individually.
+	settings    *services.AutoModSettings
+	settingsErr error
+	overall     *int
+	levels      map[string]int
+	// The selected settings row, 0 for the overall level
+	row int
+}
+
+func (p *autoModPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case AutoModSettingsLoaded:
+		p.settings, p.settingsErr = msg.settings, msg.err
+		if msg.settings != nil {
+			p.overall, p.levels = msg.settings.OverallLevel, autoModLevels(msg.settings)
+		}
+		if msg.err == nil && msg.notice != "" {
+			m.addNotice(Notice(msg.notice))
+		}
+	case tea.KeyMsg:
+		if msg.String() == "s" {
+			p.showSettings = !p.showSettings
+			if p.showSettings && p.settings == nil {
+				return m.loadAutoModSettingsCmd()
+			}
+			return nil
+		}
+		if p.showSettings {
+			return p.updateSettings(m, msg)
+		}
+		return p.updateQueue(m, msg)
+	}
+	return nil
+}
+
+func (p *autoModPanel) updateQueue(m *ChatModel, key tea.KeyMsg) tea.Cmd {
+	if len(m.heldMessages) == 0 {
+		return nil
+	}
+	selected := min(p.selected, len(m.heldMessages)-1)
+	switch key.String() {
+	case "up":
+		p.selected = max(0, selected-1)
+	case "down":
+		p.selected = min(len(m.heldMessages)-1, selected+1)
+	case "a":
+		return m.resolveHeldMessageCmd(m.heldMessages[selected], "ALLOW")
+	case "d":
+		return m.resolveHeldMessageCmd(m.heldMessages[selected], "DENY")
+	}
+	return nil
+}
+
+func (p *autoModPanel) updateSettings(m *ChatModel, key tea.KeyMsg) tea.Cmd {
+	if p.levels == nil {
+		return nil
+	}
+	switch key.String() {
+	case "up":
+		p.row = max(0, p.row-1)
+	case "down":
+		p.row = min(len(autoModCategories), p.row+1)
+	case "left", "right":
+		change := 1
+		if key.String() == "left" {
+			change = -1
+		}
+		if p.row == 0 {
+			level := 0
+			if p.overall != nil {
+				level = *p.overall
+			}
+			level = min(maxAutoModLevel, max(0, level+change))
+			p.overall = &level
+		} else {
+			// Setting a category on its own drops the overall level
+			field := autoModCategories[p.row-1].field
+			p.levels[field] = min(maxAutoModLevel, max(0, p.levels[field]+change))
+			p.overall = nil
+		}
+	case "enter":
+		if p.overall != nil {
+			return m.updateAutoModSettingsCmd(map[string]any{"overall_level": *p.overall})
+		}
+		settings := map[string]any{}
+		for field, level := range p.levels {
+			settings[field] = level
+		}
+		return m.updateAutoModSettingsCmd(settings)
+	}
+	return nil
+}
+
+func (p *autoModPanel) View(m *ChatModel, width, height int) string {
+	if p.showSettings {
+		return p.settingsView()
+	}
+	lines := []string{Header(fmt.Sprintf("AutoMod queue (%d held)", len(m.heldMessages))), ""}
+	if len(m.heldMessages) == 0 {
+		lines = append(lines, MutedStyle.Render("No messages held for review"))
+	}
+	selected := min(p.selected, len(m.heldMessages)-1)
+	for i, held := range m.heldMessages {
+		line := fmt.Sprintf("%s %s %s %s",
+			MutedStyle.Render(held.HeldAt.Local().Format("15:04")),
+			MutedStyle.Render(fmt.Sprintf("[%s %d]", held.Category, held.Level)),
+			LabelStyle.Render(held.UserName+":"), held.Message.Text)
+		if i == selected {
+			line = LabelStyle.Render("> ") + line
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *autoModPanel) settingsView() string {
+	lines := []string{Header("AutoMod settings"), ""}
+	switch {
+	case p.settingsErr != nil:
+		return strings.Join(append(lines, RenderError(p.settingsErr.Error())), "\n")
+	case p.levels == nil:
+		return strings.Join(append(lines, MutedStyle.Render("Loading…")), "\n")
+	}
+
+	overall := MutedStyle.Render("set per category")
+	if p.overall != nil {
+		overall = autoModLevel(*p.overall)
+	}
+	rows := []string{Field("Overall", overall)}
+	for _, c := range autoModCategories {
+		level := autoModLevel(p.levels[c.field])
+		if p.overall != nil {
+			level = MutedStyle.Render(fmt.Sprintf("%d", p.levels[c.field]))
+		}
+		rows = append(rows, Field(c.label, level))
+	}
+	for i, row := range rows {
+		if i == p.row {
+			row = LabelStyle.Render("> ") + row
+		} else {
+			row = "  " + row
+		}
+		lines = append(lines, row)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// autoModLevel renders a level with a bar showing how much it filters.
+func autoModLevel(level int) string {
+	return fmt.Sprintf("%d ", level) + ProgressBar(level*100/maxAutoModLevel, maxAutoModLevel*2)
+}
+
+func (p *autoModPanel) Help() string {
+	if p.showSettings {
+		return "up/down: select   left/right: change level   enter: save   s: queue   esc: close"
+	}
+	return "up/down: select   a: allow   d: deny   s: settings   esc: close"
+}
@@ -0,0 +1,133 @@
+package ui
+
+import (
+	"log"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// badgeDisplay is how badges are shown before chatters' names.
+type badgeDisplay int
+
+const (
+	badgesAsGlyphs badgeDisplay = iota
+	badgesAsLabels
+	badgesHidden
+)
+
+// cycleBadgeDisplay switches badges from glyphs to labels to hidden and
+// back, for the lines rendered from then on.
+func (m *ChatModel) cycleBadgeDisplay() {
+	m.badgeDisplay = (m.badgeDisplay + 1) % (badgesHidden + 1)
+	switch m.badgeDisplay {
+	case badgesAsLabels:
+		m.addNotice(Notice("Badges are shown as labels"))
+	case badgesHidden:
+		m.addNotice(Notice("Badges are hidden"))
+	default:
+		m.addNotice(Notice("Badges are shown as glyphs"))
+	}
+}
+
+// badgeSymbol is how a badge set is drawn in text.
+type badgeSymbol struct {
+	glyph string
+	label string
+	color string
+}
+
+// badgeSymbols are the badges given their own glyph and short label, by
+// set ID. Other badges are shown as labels from their title and left out
+// as glyphs.
+var badgeSymbols = map[string]badgeSymbol{
+	"broadcaster":  {"◉", "BC", "#E91916"},
+	"moderator":    {"⚔", "MOD", "#00AD03"},
+	"vip":          {"◆", "VIP", "#E005B9"},
+	"founder":      {"✦", "FDR", "#9146FF"},
+	"subscriber":   {"★", "SUB", "#9146FF"},
+	"staff":        {"⚙", "STAFF", "#8205B4"},
+	"partner":      {"✓", "PTNR", "#9146FF"},
+	"artist-badge": {"✎", "ART", "#1F69FF"},
+	"premium":      {"♛", "PRIME", "#0E9BD8"},
+	"turbo":        {"⚡", "TURBO", "#59399A"},
+	"bits":         {"♦", "BITS", "#FFB31A"},
+	"sub-gifter":   {"♥", "GIFT", "#9146FF"},
+}
+
+// BadgesLoaded carries the global chat badges and the channel's own.
+type BadgesLoaded struct {
+	global  []services.BadgeSet
+	channel []services.BadgeSet
+}
+
+// loadBadgesCmd reads the chat badges once so labels can be made from
+// their titles. Whatever fails to load is logged and left out.
+func (m *ChatModel) loadBadgesCmd() tea.Cmd {
+	return func() tea.Msg {
+		global, err := services.GetGlobalChatBadges(m.httpClient, m.accessToken)
+		if err != nil {
+			log.Println(err)
+		}
+		channel, err := services.GetChannelChatBadges(m.httpClient, m.accessToken, m.loggedInUser)
+		if err != nil {
+			log.Println(err)
+		}
+		return BadgesLoaded{global: global, channel: channel}
+	}
+}
+
+// setBadges indexes the badges by set and version ID. The channel's badges
+// replace the global ones of the same set.
+func (m *ChatModel) setBadges(msg BadgesLoaded) {
+	m.badges = map[string]map[string]services.BadgeVersion{}
+	for _, sets := range [][]services.BadgeSet{msg.global, msg.channel} {
+		for _, set := range sets {
+			versions := map[string]services.BadgeVersion{}
+			for _, v := range set.Versions {
+				versions[v.ID] = v
+			}
+			m.badges[set.SetID] = versions
+		}
+	}
+}
+
+// renderBadges renders a chatter's badges to go before their name, or
+// nothing when badges are hidden or they have none.
+func (m *ChatModel) renderBadges(badges []services.ChatBadge) string {
+	var parts []string
+	for _, b := range badges {
+		symbol, known := badgeSymbols[b.SetID]
+		style := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(symbol.color))
+		if !known {
+			style = MutedStyle
+		}
+
+		switch m.badgeDisplay {
+		case badgesAsGlyphs:
+			if known {
+				parts = append(parts, style.Render(symbol.glyph))
+			}
+		case badgesAsLabels:
+			label := symbol.label
+			if !known {
+				label = m.badgeTitle(b)
+			}
+			parts = append(parts, style.Render("["+label+"]"))
+		}
+	}
+	if m.badgeDisplay == badgesAsGlyphs {
+		return strings.Join(parts, "")
+	}
+	return strings.Join(parts, " ")
+}
+
+// badgeTitle names a badge from its cached title, falling back to its set ID.
+func (m *ChatModel) badgeTitle(b services.ChatBadge) string {
+	if v, ok := m.badges[b.SetID][b.ID]; ok && v.Title != "" {
+		return v.Title
+	}
+	return b.SetID
+}
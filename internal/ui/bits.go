@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// How many cheerers the leaderboard panel shows.
+const leaderboardSize = 10
+
+// leaderboardPeriods are the leaderboard periods the panel can switch
+// between, with the key that selects each.
+var leaderboardPeriods = []struct {
+	key    string
+	period string
+	label  string
+}{
+	{"d", "day", "Today"},
+	{"w", "week", "This week"},
+	{"m", "month", "This month"},
+	{"a", "all", "All time"},
+}
+
+// BitsLeaderboardLoaded carries a bits leaderboard read from the Twitch API.
+type BitsLeaderboardLoaded struct {
+	period      string
+	leaderboard *services.BitsLeaderboard
+	err         error
+}
+
+// cheerLabel renders the bit amount shown before a cheer's chat message.
+func cheerLabel(bits int) string {
+	return CheerStyle.Render(fmt.Sprintf("◆ %d bits", bits))
+}
+
+// leaderboardPanel shows the top cheerers for a period, reloading when
+// new cheers arrive.
+type leaderboardPanel struct {
+	period      string
+	leaderboard *services.BitsLeaderboard
+	err         error
+}
+
+func newLeaderboardPanel(m *ChatModel) (*leaderboardPanel, tea.Cmd) {
+	p := &leaderboardPanel{period: "week"}
+	return p, m.loadLeaderboardCmd(p.period)
+}
+
+func (m *ChatModel) loadLeaderboardCmd(period string) tea.Cmd {
+	return func() tea.Msg {
+		leaderboard, err := services.GetBitsLeaderboard(m.httpClient, m.accessToken, leaderboardSize, period)
+		return BitsLeaderboardLoaded{period: period, leaderboard: leaderboard, err: err}
+	}
+}
+
+// refreshLeaderboard reloads the leaderboard if it is on screen.
+func (m *ChatModel) refreshLeaderboard() tea.Cmd {
+	if p, ok := m.overlay.(*leaderboardPanel); ok {
+		return m.loadLeaderboardCmd(p.period)
+	}
+	return nil
+}
+
+func (p *leaderboardPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case BitsLeaderboardLoaded:
+		if msg.period == p.period {
+			p.leaderboard, p.err = msg.leaderboard, msg.err
+		}
+	case tea.KeyMsg:
+		for _, lp := range leaderboardPeriods {
+			if msg.String() == lp.key && lp.period != p.period {
+				p.period, p.leaderboard, p.err = lp.period, nil, nil
+				return m.loadLeaderboardCmd(p.period)
+			}
+		}
+	}
+	return nil
+}
+
+func (p *leaderboardPanel) View(m *ChatModel, width, height int) string {
+	title := "Bits leaderboard"
+	for _, lp := range leaderboardPeriods {
+		if lp.period == p.period {
+			title += " - " + lp.label
+		}
+	}
+	lines := []string{Header(title), ""}
+
+	switch {
+	case p.err != nil:
+		lines = append(lines, RenderError(p.err.Error()))
+	case p.leaderboard == nil:
+		lines = append(lines, MutedStyle.Render("Loading…"))
+	case len(p.leaderboard.Data) == 0:
+		lines = append(lines, MutedStyle.Render("No cheers yet"))
+	}
+	if p.leaderboard != nil {
+		for _, entry := range p.leaderboard.Data {
+			lines = append(lines, fmt.Sprintf("%3d. %-25s %s", entry.Rank, entry.UserName, CheerStyle.Render(fmt.Sprintf("%d bits", entry.Score))))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *leaderboardPanel) Help() string {
+	return "d: day   w: week   m: month   a: all time   esc: close"
+}
@@ -0,0 +1,327 @@
+package ui
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+const (
+	// Twitch's limits on a blocked term's length, in characters
+	minBlockedTermLength = 2
+	maxBlockedTermLength = 500
+	// How long an import waits between terms to stay under the rate limit
+	blockedTermImportDelay = 100 * time.Millisecond
+	// How many different failure reasons an import reports
+	importFailureReasons = 3
+)
+
+// BlockedTermsLoaded carries every blocked term in the channel.
+type BlockedTermsLoaded struct {
+	terms []services.BlockedTerm
+	err   error
+}
+
+// BlockedTermsChanged reports the outcome of adding, importing or
+// removing blocked terms.
+type BlockedTermsChanged struct {
+	notice string
+	err    error
+}
+
+func (m *ChatModel) loadBlockedTermsCmd() tea.Cmd {
+	return func() tea.Msg {
+		var terms []services.BlockedTerm
+		cursor := ""
+		for {
+			page, next, err := services.GetBlockedTerms(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, cursor)
+			if err != nil {
+				return BlockedTermsLoaded{err: err}
+			}
+			terms = append(terms, page...)
+			if next == "" {
+				return BlockedTermsLoaded{terms: terms}
+			}
+			cursor = next
+		}
+	}
+}
+
+// refreshBlockedTerms reloads the blocked terms if they are on screen.
+func (m *ChatModel) refreshBlockedTerms() tea.Cmd {
+	if _, ok := m.overlay.(*blockedTermsPanel); ok {
+		return m.loadBlockedTermsCmd()
+	}
+	return nil
+}
+
+func (m *ChatModel) addBlockedTermCmd(text string) tea.Cmd {
+	return func() tea.Msg {
+		term, err := services.AddBlockedTerm(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, text)
+		if err != nil {
+			return BlockedTermsChanged{err: err}
+		}
+		return BlockedTermsChanged{notice: "Blocked " + term.Text}
+	}
+}
+
+func (m *ChatModel) removeBlockedTermCmd(term services.BlockedTerm) tea.Cmd {
+	return func() tea.Msg {
+		if err := services.RemoveBlockedTerm(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, term.ID); err != nil {
+			return BlockedTermsChanged{err: err}
+		}
+		return BlockedTermsChanged{notice: "Unblocked " + term.Text}
+	}
+}
+
+// importBlockedTermsCmd blocks every term in a text file, one per line.
+// Blank lines and lines starting with # are skipped, as are lines too
+// short or long to be blocked. Terms are added one at a time, paced to
+// stay under Twitch's rate limit, and the reasons any failed are reported.
+func (m *ChatModel) importBlockedTermsCmd(path string) tea.Cmd {
+	return func() tea.Msg {
+		terms, err := readTermsFile(path)
+		if err != nil {
+			return BlockedTermsChanged{err: err}
+		}
+		added, skipped, failed := 0, 0, 0
+		failures := map[string]int{}
+		for _, text := range terms {
+			if n := utf8.RuneCountInString(text); n < minBlockedTermLength || n > maxBlockedTermLength {
+				skipped++
+				continue
+			}
+			if added+failed > 0 {
+				time.Sleep(blockedTermImportDelay)
+			}
+			if _, err := services.AddBlockedTerm(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, text); err != nil {
+				failed++
+				failures[err.Error()]++
+				continue
+			}
+			added++
+		}
+
+		notice := fmt.Sprintf("Imported %d of %d blocked terms from %s", added, len(terms), filepath.Base(path))
+		if skipped > 0 {
+			notice += fmt.Sprintf(". Skipped %d lines that weren't %d to %d characters long", skipped, minBlockedTermLength, maxBlockedTermLength)
+		}
+		if failed == 0 {
+			return BlockedTermsChanged{notice: notice}
+		}
+		return BlockedTermsChanged{notice: notice, err: fmt.Errorf("%d blocked terms failed: %s", failed, describeFailures(failures))}
+	}
+}
+
+// describeFailures lists the most common failure reasons with how many
+// times each happened.
+func describeFailures(failures map[string]int) string {
+	reasons := make([]string, 0, len(failures))
+	for reason := range failures {
+		reasons = append(reasons, reason)
+	}
+	slices.SortFunc(reasons, func(a, b string) int {
+		return cmp.Or(cmp.Compare(failures[b], failures[a]), cmp.Compare(a, b))
+	})
+
+	var parts []string
+	for _, reason := range reasons[:min(len(reasons), importFailureReasons)] {
+		parts = append(parts, fmt.Sprintf("%s (%d)", reason, failures[reason]))
+	}
+	if len(reasons) > importFailureReasons {
+		parts = append(parts, fmt.Sprintf("and %d other reasons", len(reasons)-importFailureReasons))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func readTermsFile(path string) ([]string, error) {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(home, rest)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var terms []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		terms = append(terms, line)
+	}
+	return terms, scanner.Err()
+}
+
+// What the blocked terms panel's input is being used for.
+type termsInputMode int
+
+const (
+	termsBrowsing termsInputMode = iota
+	termsSearching
+	termsAdding
+	termsImporting
+)
+
+// blockedTermsPanel lists the channel's blocked terms, filtered by a
+// search, and adds, imports and removes them.
+type blockedTermsPanel struct {
+	terms    []services.BlockedTerm
+	err      error
+	loaded   bool
+	query    string
+	selected int
+	mode     termsInputMode
+	input    textinput.Model
+}
+
+func newBlockedTermsPanel(m *ChatModel) (*blockedTermsPanel, tea.Cmd) {
+	return &blockedTermsPanel{input: textinput.New()}, m.loadBlockedTermsCmd()
+}
+
+// filtered returns the terms matching the search, in the order Twitch lists them.
+func (p *blockedTermsPanel) filtered() []services.BlockedTerm {
+	if p.query == "" {
+		return p.terms
+	}
+	var terms []services.BlockedTerm
+	for _, t := range p.terms {
+		if strings.Contains(strings.ToLower(t.Text), strings.ToLower(p.query)) {
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+func (p *blockedTermsPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	if loaded, ok := msg.(BlockedTermsLoaded); ok {
+		p.terms, p.err, p.loaded = loaded.terms, loaded.err, true
+		return nil
+	}
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	if p.mode != termsBrowsing {
+		return p.updateInput(m, key)
+	}
+
+	terms := p.filtered()
+	switch key.String() {
+	case "up":
+		p.selected = max(0, p.selected-1)
+	case "down":
+		p.selected = max(0, min(len(terms)-1, p.selected+1))
+	case "/":
+		return p.startInput(termsSearching, "search", p.query)
+	case "a":
+		return p.startInput(termsAdding, "word or phrase to block", "")
+	case "i":
+		return p.startInput(termsImporting, "path to a text file with one term per line", "")
+	case "x":
+		if len(terms) > 0 {
+			return m.removeBlockedTermCmd(terms[min(p.selected, len(terms)-1)])
+		}
+	}
+	return nil
+}
+
+func (p *blockedTermsPanel) startInput(mode termsInputMode, placeholder, value string) tea.Cmd {
+	p.mode = mode
+	p.input.Placeholder = placeholder
+	p.input.SetValue(value)
+	p.input.CursorEnd()
+	return p.input.Focus()
+}
+
+func (p *blockedTermsPanel) updateInput(m *ChatModel, key tea.KeyMsg) tea.Cmd {
+	if key.String() == "enter" {
+		value := strings.TrimSpace(p.input.Value())
+		mode := p.mode
+		p.mode = termsBrowsing
+		p.input.Blur()
+		switch mode {
+		case termsAdding:
+			if value != "" {
+				return m.addBlockedTermCmd(value)
+			}
+		case termsImporting:
+			if value != "" {
+				return m.importBlockedTermsCmd(value)
+			}
+		}
+		return nil
+	}
+
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(key)
+	if p.mode == termsSearching {
+		p.query = p.input.Value()
+		p.selected = 0
+	}
+	return cmd
+}
+
+func (p *blockedTermsPanel) View(m *ChatModel, width, height int) string {
+	terms := p.filtered()
+	title := fmt.Sprintf("Blocked terms (%d)", len(p.terms))
+	if p.query != "" {
+		title = fmt.Sprintf("Blocked terms (%d of %d matching %q)", len(terms), len(p.terms), p.query)
+	}
+	lines := []string{Header(title), ""}
+	if p.mode != termsBrowsing {
+		p.input.SetWidth(width)
+		lines = append(lines, p.input.View(), "")
+	}
+
+	switch {
+	case p.err != nil:
+		lines = append(lines, RenderError(p.err.Error()))
+	case !p.loaded:
+		lines = append(lines, MutedStyle.Render("Loading…"))
+	case len(terms) == 0:
+		lines = append(lines, MutedStyle.Render("No blocked terms"))
+	}
+
+	// Scroll to keep the selected term in view
+	selected := min(p.selected, len(terms)-1)
+	visible := max(1, height-len(lines))
+	start := max(0, selected-visible+1)
+	for i := start; i < len(terms) && i < start+visible; i++ {
+		line := terms[i].Text
+		if terms[i].ExpiresAt != nil {
+			line += " " + MutedStyle.Render("until "+terms[i].ExpiresAt.Local().Format("Jan 2 15:04"))
+		}
+		if i == selected {
+			line = LabelStyle.Render("> ") + line
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *blockedTermsPanel) Help() string {
+	if p.mode != termsBrowsing {
+		return "enter: done   esc: close"
+	}
+	return "/: search   a: add   i: import file   x: remove   esc: close"
+}
@@ -0,0 +1,300 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+const (
+	// How long typing in the category field must pause before searching
+	categorySearchDelay = 250 * time.Millisecond
+	// How many matching categories are suggested
+	categorySuggestions = 8
+	// Twitch's limits on a channel's tags
+	maxTags      = 10
+	maxTagLength = 25
+)
+
+// The fields of the channel information form, in order.
+const (
+	channelTitleField = iota
+	channelCategoryField
+	channelTagsField
+	channelLanguageField
+)
+
+// ChannelInfoLoaded carries the channel information shown in the form.
+type ChannelInfoLoaded struct {
+	info *services.ChannelInfo
+	err  error
+}
+
+// categorySearchDue is sent once typing in the category field pauses.
+// Searches for anything but the latest text are dropped.
+type categorySearchDue struct {
+	seq int
+}
+
+type categoriesFound struct {
+	seq        int
+	categories []services.Category
+	err        error
+}
+
+// channelInfoPanel is a form for the stream's title, category, tags and
+// language. The category is picked from a search of Twitch's categories.
+type channelInfoPanel struct {
+	info     *services.ChannelInfo
+	err      error
+	fields   []formField
+	focused  int
+	category services.Category
+	// Suggestions for the category being typed, and the search they came from
+	searchSeq   int
+	suggestions []services.Category
+	suggestion  int
+}
+
+func newChannelInfoPanel(m *ChatModel) (*channelInfoPanel, tea.Cmd) {
+	p := &channelInfoPanel{}
+	for _, label := range []string{"Title", "Category", "Tags", "Language"} {
+		p.fields = append(p.fields, formField{label: label, input: textinput.New()})
+	}
+	p.fields[channelTagsField].input.Placeholder = "comma separated"
+	p.fields[channelLanguageField].input.Placeholder = "two letter code, e.g. en, or other"
+
+	return p, func() tea.Msg {
+		info, err := services.GetChannelInformation(m.httpClient, m.accessToken, m.loggedInUser)
+		return ChannelInfoLoaded{info: info, err: err}
+	}
+}
+
+func (m *ChatModel) searchCategoriesCmd(query string, seq int) tea.Cmd {
+	return func() tea.Msg {
+		categories, err := services.SearchCategories(m.httpClient, m.accessToken, query, categorySuggestions)
+		return categoriesFound{seq: seq, categories: categories, err: err}
+	}
+}
+
+func (m *ChatModel) modifyChannelInformationCmd(changes map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		if err := services.ModifyChannelInformation(m.httpClient, m.accessToken, m.loggedInUser, changes); err != nil {
+			return ActionDone{err: err}
+		}
+		return ActionDone{notice: "Channel information updated"}
+	}
+}
+
+func (p *channelInfoPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case ChannelInfoLoaded:
+		p.info, p.err = msg.info, msg.err
+		if msg.err != nil {
+			return nil
+		}
+		p.category = services.Category{ID: msg.info.GameID, Name: msg.info.GameName}
+		p.setValue(channelTitleField, msg.info.Title)
+		p.setValue(channelCategoryField, msg.info.GameName)
+		p.setValue(channelTagsField, strings.Join(msg.info.Tags, ", "))
+		p.setValue(channelLanguageField, msg.info.BroadcasterLanguage)
+		return p.focus(channelTitleField)
+	case categorySearchDue:
+		if msg.seq != p.searchSeq {
+			return nil
+		}
+		return m.searchCategoriesCmd(p.fields[channelCategoryField].input.Value(), msg.seq)
+	case categoriesFound:
+		if msg.seq != p.searchSeq {
+			return nil
+		}
+		if msg.err != nil {
+			p.err = msg.err
+			return nil
+		}
+		p.err = nil
+		p.suggestions, p.suggestion = msg.categories, 0
+		return nil
+	case tea.KeyMsg:
+		if p.info == nil {
+			return nil
+		}
+		return p.updateKey(m, msg)
+	}
+	return nil
+}
+
+func (p *channelInfoPanel) updateKey(m *ChatModel, key tea.KeyMsg) tea.Cmd {
+	if p.focused == channelCategoryField && len(p.suggestions) > 0 {
+		switch key.String() {
+		case "up":
+			p.suggestion = max(0, p.suggestion-1)
+			return nil
+		case "down":
+			p.suggestion = min(len(p.suggestions)-1, p.suggestion+1)
+			return nil
+		case "tab", "enter":
+			p.category = p.suggestions[p.suggestion]
+			p.setValue(channelCategoryField, p.category.Name)
+			p.suggestions = nil
+			return p.focus(channelTagsField)
+		}
+	}
+
+	switch key.String() {
+	case "tab", "down":
+		return p.focus((p.focused + 1) % len(p.fields))
+	case "shift+tab", "up":
+		return p.focus((p.focused + len(p.fields) - 1) % len(p.fields))
+	case "enter":
+		if p.focused < len(p.fields)-1 {
+			return p.focus(p.focused + 1)
+		}
+		changes, err := p.changes()
+		if err != nil {
+			p.err = err
+			return nil
+		}
+		m.closeOverlay()
+		if len(changes) == 0 {
+			return nil
+		}
+		return m.modifyChannelInformationCmd(changes)
+	}
+
+	field := &p.fields[p.focused]
+	before := field.input.Value()
+	var cmd tea.Cmd
+	field.input, cmd = field.input.Update(key)
+	if p.focused != channelCategoryField || field.input.Value() == before {
+		return cmd
+	}
+
+	// Search once typing pauses, dropping the suggestions for older text
+	p.searchSeq++
+	p.suggestions = nil
+	if strings.TrimSpace(field.input.Value()) == "" {
+		return cmd
+	}
+	seq := p.searchSeq
+	return tea.Batch(cmd, tea.Tick(categorySearchDelay, func(time.Time) tea.Msg { return categorySearchDue{seq: seq} }))
+}
+
+func (p *channelInfoPanel) setValue(field int, value string) {
+	p.fields[field].input.SetValue(value)
+	p.fields[field].input.CursorEnd()
+}
+
+func (p *channelInfoPanel) focus(field int) tea.Cmd {
+	p.fields[p.focused].input.Blur()
+	p.focused = field
+	return p.fields[p.focused].input.Focus()
+}
+
+// changes returns the fields that differ from the loaded channel
+// information, keyed by their Twitch API names.
+func (p *channelInfoPanel) changes() (map[string]any, error) {
+	changes := map[string]any{}
+
+	title := strings.TrimSpace(p.fields[channelTitleField].input.Value())
+	if title == "" {
+		return nil, errors.New("the title can't be empty")
+	}
+	if title != p.info.Title {
+		changes["title"] = title
+	}
+
+	category := strings.TrimSpace(p.fields[channelCategoryField].input.Value())
+	switch {
+	case category == "":
+		// An empty game ID takes the channel out of any category
+		if p.info.GameID != "" {
+			changes["game_id"] = ""
+		}
+	case category != p.category.Name:
+		return nil, errors.New("pick a category from the suggestions")
+	case p.category.ID != p.info.GameID:
+		changes["game_id"] = p.category.ID
+	}
+
+	tags := parseTags(p.fields[channelTagsField].input.Value())
+	if len(tags) > maxTags {
+		return nil, fmt.Errorf("a channel can have at most %d tags", maxTags)
+	}
+	for _, tag := range tags {
+		if len([]rune(tag)) > maxTagLength {
+			return nil, fmt.Errorf("the tag %q is longer than %d characters", tag, maxTagLength)
+		}
+	}
+	if !slices.Equal(tags, p.info.Tags) {
+		changes["tags"] = tags
+	}
+
+	language := strings.ToLower(strings.TrimSpace(p.fields[channelLanguageField].input.Value()))
+	if language == "" {
+		return nil, errors.New("the language can't be empty")
+	}
+	if language != p.info.BroadcasterLanguage {
+		changes["broadcaster_language"] = language
+	}
+	return changes, nil
+}
+
+// parseTags splits comma separated tags, dropping empty ones.
+func parseTags(s string) []string {
+	tags := []string{}
+	for _, tag := range strings.Split(s, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func (p *channelInfoPanel) View(m *ChatModel, width, height int) string {
+	lines := []string{Header("Channel information"), ""}
+	if p.info == nil {
+		if p.err != nil {
+			return strings.Join(append(lines, RenderError(p.err.Error())), "\n")
+		}
+		return strings.Join(append(lines, MutedStyle.Render("Loading…")), "\n")
+	}
+
+	labelWidth := 0
+	for _, field := range p.fields {
+		labelWidth = max(labelWidth, len(field.label)+1)
+	}
+	for i := range p.fields {
+		field := &p.fields[i]
+		field.input.SetWidth(max(1, width-labelWidth-3))
+		lines = append(lines, LabelStyle.Width(labelWidth).Render(field.label+":")+" "+field.input.View())
+		if i != channelCategoryField || p.focused != channelCategoryField {
+			continue
+		}
+		indent := strings.Repeat(" ", labelWidth+1)
+		for j, c := range p.suggestions {
+			if j == p.suggestion {
+				lines = append(lines, indent+LabelStyle.Render("> ")+c.Name)
+			} else {
+				lines = append(lines, indent+"  "+MutedStyle.Render(c.Name))
+			}
+		}
+	}
+	if p.err != nil {
+		lines = append(lines, "", RenderError(p.err.Error()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *channelInfoPanel) Help() string {
+	if p.focused == channelCategoryField && len(p.suggestions) > 0 {
+		return "up/down: select category   tab/enter: pick   esc: cancel"
+	}
+	return "tab: next field   enter: next/save   esc: cancel"
+}
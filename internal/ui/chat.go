@@ -1,8 +1,11 @@
 package ui
 
 import (
+	"fmt"
 	"log"
 	"net/http"
+	"strings"
+	"time"
 
 	"charm.land/bubbles/v2/list"
 	"charm.land/bubbles/v2/textinput"
@@ -15,6 +18,7 @@ import (
 
 type ChatModel struct {
 	chat                components.ChatStack
+	history             []chatLine // one entry per line in chat, oldest first
 	input               textinput.Model
 	participants        list.Model
 	participantsVisible bool
@@ -25,14 +29,60 @@ type ChatModel struct {
 	httpClient          *http.Client
 	wsConn              *websocket.Conn
 	accessToken         string
-	loggedInUser        string // The authenticated user's ID
-	sessionID           string // The EventSub Session ID
+	loggedInUser        string    // The authenticated user's ID
+	sessionID           string    // The EventSub Session ID
+	overlay             overlay   // The panel shown over the chat room, if any
+	replyTo             *chatLine // The message the next sent message replies to
+	chatSettings        *services.ChatSettings
+	chatSettingsErr     error
+	whispers            []*conversation // most recently active first
+	stream              streamState
+	hiddenAlerts        map[alertKind]bool
+	redemptions         []services.RedemptionAddEvent // waiting to be fulfilled or canceled, oldest first
+	poll                *pollState
+	prediction          *predictionState
+	raid                raidSettings
+	modLog              []modLogEntry                  // oldest first
+	heldMessages        []services.AutoModMessageEvent // held by AutoMod for review, oldest first
+	unbanRequests       []services.UnbanRequest        // pending, oldest first
+	shieldMode          bool
+	lowTrust            map[string]string   // low trust status by user ID, for monitored and restricted users
+	warnings            map[string]*warning // by user ID, the last warning sent this session
+	moderators          map[string]bool     // by user ID
+	vips                map[string]bool     // by user ID
+	clips               []sessionClip       // created this session, oldest first
+	emotes              []services.Emote    // usable by the logged-in user, sorted by name
+	badgeDisplay        badgeDisplay
+	badges              map[string]map[string]services.BadgeVersion // by set ID, then version ID
 }
 
+// chatLine is what the chat knows about a line in the ChatStack.
+// Notices have no message ID or user.
+type chatLine struct {
+	messageID string
+	userID    string
+	login     string
+	name      string
+	text      string
+	sentAt    time.Time
+	// lowTrust is set once the line is marked as from a suspicious user.
+	lowTrust string
+	rendered string
+}
+
+// The stream header is a single line above the chat box
+const streamHeaderHeight = 1
+
 type ChatMsgSent struct {
 	err error
 }
 
+// ActionDone reports the outcome of an action taken through the Twitch API.
+type ActionDone struct {
+	notice string
+	err    error
+}
+
 type ChatInit struct {
 	userID string
 	conn   *websocket.Conn
@@ -51,10 +101,7 @@ type EventReceived struct {
 func NewChatModel(httpClient *http.Client, accessToken string) *ChatModel {
 	in := textinput.New()
 	in.Focus()
-	items := []list.Item{
-		components.Participant{Name: "GrazhProtiv"},
-	}
-	participants := list.New(items, list.NewDefaultDelegate(), 20, 0)
+	participants := list.New([]list.Item{}, list.NewDefaultDelegate(), 20, 0)
 	participants.SetShowTitle(false)
 	participants.SetShowHelp(false)
 	participants.SetShowStatusBar(false)
@@ -66,6 +113,10 @@ func NewChatModel(httpClient *http.Client, accessToken string) *ChatModel {
 		inputFocused:        false,
 		httpClient:          httpClient,
 		accessToken:         accessToken,
+		lowTrust:            map[string]string{},
+		warnings:            map[string]*warning{},
+		moderators:          map[string]bool{},
+		vips:                map[string]bool{},
 	}
 }
 
@@ -109,16 +160,20 @@ func (m *ChatModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	case SessionIDReceived:
 		m.sessionID = msg.sessionID
-		chatSubReq := services.ChannelChatMessageSub(m.loggedInUser, m.sessionID)
+		subReqs := m.eventSubscriptions()
 
-		return m, func() tea.Msg {
-			err := services.CreateEventSub(m.httpClient, m.accessToken, m.sessionID, chatSubReq)
-			if err != nil {
-				log.Printf("failed to create event subscription %s", err.Error())
-				return nil
+		subscribe := func() tea.Msg {
+			// Keep going when a subscription fails, e.g. for a missing scope,
+			// so that the rest of the chat still works
+			for _, subReq := range subReqs {
+				err := services.CreateEventSub(m.httpClient, m.accessToken, m.sessionID, subReq)
+				if err != nil {
+					log.Printf("failed to create %s event subscription %s", subReq["type"], err.Error())
+				}
 			}
 			return m.readWebsocket()
 		}
+		return m, tea.Batch(subscribe, m.loadChatSettingsCmd(), m.loadStreamCmd(), m.loadShieldModeCmd(), m.loadRolesCmd(), m.loadEmotesCmd(), m.loadBadgesCmd(), clockTickCmd())
 	case EventReceived:
 		if msg.err != nil {
 			logErr := func() tea.Msg {
@@ -134,25 +189,134 @@ func (m *ChatModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return nil
 		}
 
-		return m, tea.Batch(logEvent, m.readWebsocket)
+		n, err := services.ParseNotification(msg.event)
+		if err != nil || n.Metadata.MessageType != "notification" {
+			return m, tea.Batch(logEvent, m.readWebsocket)
+		}
+		return m, tea.Batch(logEvent, m.handleNotification(n), m.readWebsocket)
 	case tea.WindowSizeMsg:
 		m.Width = msg.Width
 		m.Height = msg.Height
 		chatInputHeight := ChatInputStyle.GetVerticalFrameSize() +
 			FooterStyle.GetVerticalFrameSize() +
-			ChatBoxStyle.GetVerticalFrameSize() + 2
+			ChatBoxStyle.GetVerticalFrameSize() + 2 +
+			streamHeaderHeight
 
 		m.toggleChatWidth()
 
 		m.chat.SetHeight(m.Height - chatInputHeight)
 		m.participants.SetHeight(m.Height - chatInputHeight)
 
+		return m, nil
+	case ClockTick:
+		return m, clockTickCmd()
+	case StreamLoaded:
+		if msg.err != nil {
+			log.Println(msg.err)
+		}
+		m.setStream(msg)
+		return m, m.loadAdScheduleIfLive()
+	case AdScheduleLoaded:
+		m.setAdSchedule(msg)
+		return m, nil
+	case AdBreakEnded:
+		return m, m.adBreakEnded()
+	case ChatSettingsLoaded:
+		if msg.err != nil {
+			if m.chatSettings == nil {
+				m.chatSettingsErr = msg.err
+			}
+			m.addNotice(RenderError(msg.err.Error()))
+			return m, nil
+		}
+		m.chatSettings, m.chatSettingsErr = msg.settings, nil
+		if msg.notice != "" {
+			m.addNotice(Notice(msg.notice))
+		}
+		return m, nil
+	case WhisperSent:
+		if msg.err != nil {
+			m.addNotice(RenderError(msg.err.Error()))
+			return m, nil
+		}
+		m.addSentWhisper(msg)
+		return m, nil
+	case PollLoaded:
+		m.pollLoaded(msg)
+		return m, nil
+	case BlockedTermsChanged:
+		if msg.notice != "" {
+			m.addNotice(Notice(msg.notice))
+		}
+		if msg.err != nil {
+			m.addNotice(RenderError(msg.err.Error()))
+		}
+		return m, m.refreshBlockedTerms()
+	case ShieldModeLoaded:
+		m.setShieldMode(msg)
+		return m, nil
+	case BadgesLoaded:
+		m.setBadges(msg)
+		return m, nil
+	case EmotesLoaded:
+		m.setEmotes(msg)
+		return m, nil
+	case ClipCreated:
+		m.clipCreated(msg)
+		return m, nil
+	case RolesLoaded:
+		m.setRoles(msg)
+		return m, nil
+	case RosterChanged:
+		if msg.err != nil {
+			m.addNotice(RenderError(msg.err.Error()))
+		}
+		return m, m.refreshRoster()
+	case UnbanRequestResolved:
+		if msg.err != nil {
+			m.addNotice(RenderError(msg.err.Error()))
+			return m, nil
+		}
+		m.removeUnbanRequest(msg.request.ID)
+		m.addNotice(Notice(fmt.Sprintf("You %s the unban request from %s", msg.request.Status, msg.request.UserName)))
+		return m, nil
+	case HeldMessageResolved:
+		m.heldMessageResolved(msg)
+		return m, nil
+	case RaidProtectionEnded:
+		return m, m.endRaidProtection()
+	case PredictionLoaded:
+		m.predictionLoaded(msg)
+		return m, nil
+	case RedemptionUpdated:
+		m.redemptionUpdated(msg)
+		return m, nil
+	case ActionDone:
+		if msg.err != nil {
+			m.addNotice(RenderError(msg.err.Error()))
+		} else if msg.notice != "" {
+			m.addNotice(Notice(msg.notice))
+		}
 		return m, nil
 	case tea.KeyMsg:
+		if m.overlay != nil {
+			if msg.String() == "esc" {
+				m.closeOverlay()
+				return m, nil
+			}
+			return m, m.overlay.Update(m, msg)
+		}
+		if msg.String() == "esc" && m.replyTo != nil {
+			m.replyTo = nil
+			return m, nil
+		}
 		if msg.String() == "esc" {
 			m.logout = true
 			return m, nil
 		}
+		if msg.String() == "ctrl+e" {
+			return m, m.openOverlay(newEmotePicker())
+		}
 		if msg.String() == "tab" {
 			m.inputFocused = !m.inputFocused
 			if m.inputFocused {
@@ -169,23 +333,104 @@ func (m *ChatModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			return m, nil
 		}
+		if !m.inputFocused {
+			switch msg.String() {
+			case "s":
+				return m, m.openOverlay(&settingsPanel{}, nil)
+			case "w":
+				return m, m.openOverlay(newWhispersPanel(m))
+			case "a":
+				return m, m.openOverlay(&alertsPanel{}, nil)
+			case "l":
+				return m, m.openOverlay(newLeaderboardPanel(m))
+			case "r":
+				return m, m.openOverlay(newRedemptionsPanel(m))
+			case "p":
+				return m, m.openOverlay(newPollPanel(m))
+			case "o":
+				return m, m.openOverlay(newPredictionPanel(m))
+			case "m":
+				return m, m.openOverlay(&modLogPanel{}, nil)
+			case "h":
+				return m, m.openOverlay(&autoModPanel{}, nil)
+			case "k":
+				return m, m.openOverlay(newBlockedTermsPanel(m))
+			case "q":
+				return m, m.openOverlay(newUnbanRequestsPanel(m))
+			case "e":
+				return m, m.openOverlay(newChannelInfoPanel(m))
+			case "x":
+				return m, m.createClipCmd()
+			case "X":
+				return m, m.openOverlay(&clipsPanel{}, nil)
+			case "f":
+				return m, m.createMarkerCmd()
+			case "v":
+				return m, m.openOverlay(newRosterPanel(m))
+			case "!":
+				return m, m.toggleShieldModeCmd()
+			case "B":
+				m.cycleBadgeDisplay()
+				return m, nil
+			case "?":
+				return m, m.openOverlay(&keysPanel{}, nil)
+			case "[":
+				m.chat.SelectPrevious()
+				return m, nil
+			case "]":
+				m.chat.SelectNext()
+				return m, nil
+			case "u", "d", "t", "b":
+				line, ok := m.selectedLine()
+				if !ok || line.userID == "" {
+					return m, nil
+				}
+				switch msg.String() {
+				case "u":
+					return m, m.openOverlay(newUserCard(m, line.userID, line.login, line.name))
+				case "d":
+					// Alerts and other lines that aren't chat messages can't be deleted
+					if line.messageID == "" {
+						return m, nil
+					}
+					return m, m.promptDelete(line)
+				case "t":
+					return m, m.promptBan(line.userID, line.name, defaultTimeoutSeconds)
+				case "b":
+					return m, m.promptBan(line.userID, line.name, 0)
+				}
+			case "enter":
+				if !m.participantsVisible || m.participants.SettingFilter() {
+					break
+				}
+				if p, ok := m.participants.SelectedItem().(components.Participant); ok {
+					return m, m.openOverlay(newUserCard(m, p.ID, p.Login, p.Name))
+				}
+				return m, nil
+			}
+		}
 		if m.inputFocused {
 			if msg.String() == "enter" {
 				val := m.input.Value()
 				if val != "" {
 					m.input.SetValue("")
-					return m, func() tea.Msg {
-						err := services.SendMessage(m.httpClient, m.accessToken, m.loggedInUser, val)
-						if err != nil {
-							log.Println(err)
-						}
-						return ChatMsgSent{err: err}
+					// A pending reply is dropped by commands too, so it can't
+					// attach itself to a later message
+					replyTo := m.replyTo
+					m.replyTo = nil
+					if name, args, ok := parseCommand(val); ok {
+						return m, m.runCommand(name, args)
 					}
+					return m, m.sendMessageCmd(val, replyTo)
 				}
 			}
 		}
 	}
 
+	if m.overlay != nil {
+		return m, m.overlay.Update(m, msg)
+	}
+
 	m.input, _ = m.input.Update(msg)
 	m.participants, _ = m.participants.Update(msg)
 	return m, nil
@@ -199,6 +444,9 @@ func (m *ChatModel) View() tea.View {
 	} // else leave blank
 	chatAndParticipantsView := lipgloss.JoinHorizontal(lipgloss.Top, chatView, participantsView)
 	roomView := lipgloss.PlaceHorizontal(m.Width, lipgloss.Left, chatAndParticipantsView) + "\n"
+	if m.overlay != nil {
+		roomView = m.overlayView(lipgloss.Height(chatView)) + "\n"
+	}
 
 	widthOffset := ChatInputStyle.GetHorizontalMargins()
 	inputField := ChatInputStyle.Width(m.Width - widthOffset).Render(m.input.View())
@@ -207,13 +455,22 @@ func (m *ChatModel) View() tea.View {
 	}
 
 	footer := ""
-	if m.inputFocused {
-		footer = FooterStyle.Render("tab: toggle input   enter: send   esc: logout")
+	if m.overlay != nil {
+		footer = FooterStyle.Render(m.overlay.Help())
+	} else if m.replyTo != nil {
+		footer = FooterStyle.Render("replying to " + m.replyTo.name + "   enter: send   esc: cancel reply")
+	} else if m.inputFocused {
+		footer = FooterStyle.Render("tab: toggle input   enter: send   ctrl+e: emotes   /help: commands   esc: logout")
 	} else {
-		footer = FooterStyle.Render("tab: toggle input   c: toggle chatters   esc: logout")
+		whispers := "w: whispers"
+		if unread := m.unreadWhispers(); unread > 0 {
+			whispers += " " + BadgeStyle.Render(fmt.Sprintf("%d", unread))
+		}
+		footer = FooterStyle.Render("tab: toggle input   c: toggle chatters   " + whispers + "   ?: more keys   esc: logout")
 	}
 
-	inputAndFooter := lipgloss.PlaceVertical(m.Height, lipgloss.Bottom, roomView+inputField+footer)
+	header := m.streamHeader() + "\n"
+	inputAndFooter := lipgloss.PlaceVertical(m.Height, lipgloss.Bottom, header+roomView+inputField+footer)
 
 	view := tea.NewView(inputAndFooter)
 	view.AltScreen = true
@@ -238,6 +495,137 @@ func (m *ChatModel) readWebsocket() tea.Msg {
 	return EventReceived{event: event}
 }
 
+// addChatMessage renders a chat message into the ChatStack and records its
+// author as a participant.
+func (m *ChatModel) addChatMessage(event services.ChatMessageEvent) {
+	if m.hasMessage(event.MessageID) {
+		// Already shown from a channel.suspicious_user.message event
+		return
+	}
+	name := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(event.Color)).Render(event.ChatterUserName)
+	if badges := m.renderBadges(event.Badges); badges != "" {
+		name = badges + " " + name
+	}
+	rendered := name + ": " + renderFragments(event.Message.Fragments, event.Message.Text)
+	if event.Cheer != nil {
+		rendered = cheerLabel(event.Cheer.Bits) + " " + rendered
+	}
+	if event.Reply != nil {
+		rendered = MutedStyle.Render("↳ @"+event.Reply.ParentUserName) + " " + rendered
+	}
+	lowTrust := m.lowTrust[event.ChatterUserID]
+	if lowTrust != "" {
+		rendered = suspiciousMarker(lowTrust) + " " + rendered
+	}
+
+	m.appendLine(chatLine{
+		messageID: event.MessageID,
+		userID:    event.ChatterUserID,
+		login:     event.ChatterUserLogin,
+		name:      event.ChatterUserName,
+		text:      event.Message.Text,
+		sentAt:    time.Now(),
+		lowTrust:  lowTrust,
+	}, rendered)
+	m.addParticipant(components.Participant{
+		ID:    event.ChatterUserID,
+		Login: event.ChatterUserLogin,
+		Name:  event.ChatterUserName,
+	})
+}
+
+// renderFragments renders a chat message from its fragments, falling back
+// to its plain text when it has none.
+func renderFragments(fragments []services.ChatMessageFragment, text string) string {
+	if len(fragments) == 0 {
+		return text
+	}
+	b := strings.Builder{}
+	for _, f := range fragments {
+		switch f.Type {
+		case "cheermote":
+			b.WriteString(CheerStyle.Render(f.Text))
+		case "emote":
+			b.WriteString(renderEmote(f.Text))
+		default:
+			b.WriteString(f.Text)
+		}
+	}
+	return b.String()
+}
+
+// addNotice adds an already rendered line that no user sent to the ChatStack.
+func (m *ChatModel) addNotice(rendered string) {
+	m.appendLine(chatLine{sentAt: time.Now()}, rendered)
+}
+
+func (m *ChatModel) appendLine(line chatLine, rendered string) {
+	line.rendered = rendered
+	m.history = append(m.history, line)
+	m.chat.AddMessage(rendered)
+}
+
+func (m *ChatModel) addParticipant(p components.Participant) {
+	for _, item := range m.participants.Items() {
+		if existing, ok := item.(components.Participant); ok && existing.ID == p.ID {
+			return
+		}
+	}
+	m.participants.InsertItem(len(m.participants.Items()), m.withRoles(p))
+}
+
+// selectedLine returns the line selected in the ChatStack.
+func (m *ChatModel) selectedLine() (chatLine, bool) {
+	idx, ok := m.chat.Selected()
+	if !ok || idx >= len(m.history) {
+		return chatLine{}, false
+	}
+	return m.history[idx], true
+}
+
+// messagesFrom returns up to limit of the user's most recent messages, oldest first.
+func (m *ChatModel) messagesFrom(userID string, limit int) []chatLine {
+	var lines []chatLine
+	for i := len(m.history) - 1; i >= 0 && len(lines) < limit; i-- {
+		if m.history[i].userID == userID && m.history[i].messageID != "" {
+			lines = append([]chatLine{m.history[i]}, lines...)
+		}
+	}
+	return lines
+}
+
+func (m *ChatModel) lastMessageFrom(userID string) (chatLine, bool) {
+	lines := m.messagesFrom(userID, 1)
+	if len(lines) == 0 {
+		return chatLine{}, false
+	}
+	return lines[0], true
+}
+
+// sendMessageCmd sends a chat message, as a reply when replyTo is set.
+func (m *ChatModel) sendMessageCmd(text string, replyTo *chatLine) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		if replyTo != nil {
+			err = services.SendReply(m.httpClient, m.accessToken, m.loggedInUser, replyTo.messageID, text)
+		} else {
+			err = services.SendMessage(m.httpClient, m.accessToken, m.loggedInUser, text)
+		}
+		if err != nil {
+			log.Println(err)
+		}
+		return ChatMsgSent{err: err}
+	}
+}
+
+// startInput focuses the chat input with text already typed.
+func (m *ChatModel) startInput(text string) tea.Cmd {
+	m.inputFocused = true
+	m.input.SetValue(text)
+	m.input.CursorEnd()
+	return m.input.Focus()
+}
+
 // LoggedOut reports whether the user has requested to log out (esc).
 func (m *ChatModel) LoggedOut() bool {
 	return m.logout
@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// ClipCreated reports the outcome of creating a clip.
+type ClipCreated struct {
+	clip *services.Clip
+	err  error
+}
+
+// sessionClip is a clip created from this chat.
+type sessionClip struct {
+	clip services.Clip
+	at   time.Time
+}
+
+func (m *ChatModel) createClipCmd() tea.Cmd {
+	return func() tea.Msg {
+		clip, err := services.CreateClip(m.httpClient, m.accessToken, m.loggedInUser)
+		return ClipCreated{clip: clip, err: err}
+	}
+}
+
+func (m *ChatModel) clipCreated(msg ClipCreated) {
+	if msg.err != nil {
+		m.addNotice(RenderError(msg.err.Error()))
+		return
+	}
+	m.clips = append(m.clips, sessionClip{clip: *msg.clip, at: time.Now()})
+	m.addNotice(Notice(fmt.Sprintf("Clip created: %s (edit at %s)", msg.clip.URL(), msg.clip.EditURL)))
+}
+
+// createMarkerCmd marks the live stream, described by the selected message
+// if there is one.
+func (m *ChatModel) createMarkerCmd() tea.Cmd {
+	description := ""
+	if line, ok := m.selectedLine(); ok && line.messageID != "" {
+		description = truncateRunes(line.name+": "+line.text, services.MaxMarkerDescriptionLength)
+	}
+	return func() tea.Msg {
+		marker, err := services.CreateStreamMarker(m.httpClient, m.accessToken, m.loggedInUser, description)
+		if err != nil {
+			return ActionDone{err: err}
+		}
+		position := formatUptime(time.Duration(marker.PositionSeconds) * time.Second)
+		if marker.Description == "" {
+			return ActionDone{notice: "Stream marker added at " + position}
+		}
+		return ActionDone{notice: fmt.Sprintf("Stream marker added at %s: %s", position, marker.Description)}
+	}
+}
+
+// truncateRunes shortens s to at most n runes, ending in an ellipsis when cut.
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
+
+// clipsPanel lists the clips created this session, most recent last.
+type clipsPanel struct{}
+
+func (p *clipsPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	return nil
+}
+
+func (p *clipsPanel) View(m *ChatModel, width, height int) string {
+	lines := []string{Header(fmt.Sprintf("Clips this session (%d)", len(m.clips))), ""}
+	if len(m.clips) == 0 {
+		lines = append(lines, MutedStyle.Render("No clips yet. Press x in chat to clip the stream"))
+	}
+	// Keep the most recent clips in view, two lines each
+	visible := max(1, (height-len(lines))/2)
+	for _, c := range m.clips[max(0, len(m.clips)-visible):] {
+		lines = append(lines,
+			MutedStyle.Render(c.at.Format("15:04"))+" "+c.clip.URL(),
+			"      "+MutedStyle.Render("edit: "+c.clip.EditURL),
+		)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *clipsPanel) Help() string {
+	return "esc: close"
+}
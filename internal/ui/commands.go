@@ -0,0 +1,258 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// errUsage is returned by a command that was given the wrong arguments,
+// so that its usage is shown instead of an error.
+var errUsage = errors.New("wrong arguments")
+
+// command is a Twitch-style slash command typed into the chat input.
+type command struct {
+	name  string
+	usage string
+	help  string
+	// run returns the command to execute for the given arguments.
+	run func(m *ChatModel, args []string) (tea.Cmd, error)
+}
+
+// commands holds every registered slash command by name.
+var commands = map[string]command{}
+
+// registerCommand makes a slash command available in the chat input.
+func registerCommand(c command) {
+	commands[c.name] = c
+}
+
+func init() {
+	registerCommand(command{
+		name:  "help",
+		usage: "/help",
+		help:  "List the available commands",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			names := make([]string, 0, len(commands))
+			for name := range commands {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+			for _, name := range names {
+				m.addNotice(Notice(fmt.Sprintf("%s - %s", commands[name].usage, commands[name].help)))
+			}
+			return nil, nil
+		},
+	})
+	registerCommand(command{
+		name:  "me",
+		usage: "/me <message>",
+		help:  "Send a message (the Twitch API has no action messages)",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			if len(args) == 0 {
+				return nil, errUsage
+			}
+			return m.sendMessageCmd(strings.Join(args, " "), nil), nil
+		},
+	})
+	registerCommand(command{
+		name:  "ban",
+		usage: "/ban <user> [reason]",
+		help:  "Permanently ban a user from chat",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			if len(args) == 0 {
+				return nil, errUsage
+			}
+			reason := strings.Join(args[1:], " ")
+			return m.withUser(args[0], func(user services.UserInfo) tea.Cmd {
+				return m.banCmd(user.ID, user.DisplayName, 0, reason)
+			}), nil
+		},
+	})
+	registerCommand(command{
+		name:  "unban",
+		usage: "/unban <user>",
+		help:  "Lift a ban or timeout",
+		run:   unbanCommand,
+	})
+	registerCommand(command{
+		name:  "untimeout",
+		usage: "/untimeout <user>",
+		help:  "Lift a timeout",
+		run:   unbanCommand,
+	})
+	registerCommand(command{
+		name:  "timeout",
+		usage: "/timeout <user> [duration] [reason]",
+		help:  "Temporarily ban a user for 1s to 2 weeks, in seconds or a duration like 10m",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			if len(args) == 0 {
+				return nil, errUsage
+			}
+			duration := defaultTimeoutSeconds
+			reasonArgs := args[1:]
+			if len(args) > 1 {
+				if seconds, err := parseDurationSeconds(args[1]); err == nil {
+					if seconds < minTimeoutSeconds || seconds > maxTimeoutSeconds {
+						return nil, errUsage
+					}
+					duration = seconds
+					reasonArgs = args[2:]
+				}
+			}
+			reason := strings.Join(reasonArgs, " ")
+			return m.withUser(args[0], func(user services.UserInfo) tea.Cmd {
+				return m.banCmd(user.ID, user.DisplayName, duration, reason)
+			}), nil
+		},
+	})
+	registerCommand(command{
+		name:  "clear",
+		usage: "/clear",
+		help:  "Delete every message in chat",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			return m.clearChatCmd(), nil
+		},
+	})
+	registerCommand(command{
+		name:  "slow",
+		usage: "/slow [seconds]",
+		help:  "Limit how often users may send messages, every 3 to 120 seconds",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			wait := defaultSlowModeSeconds
+			if len(args) > 0 {
+				seconds, err := parseDurationSeconds(args[0])
+				if err != nil || seconds < minSlowModeSeconds || seconds > maxSlowModeSeconds {
+					return nil, errUsage
+				}
+				wait = seconds
+			}
+			return m.updateChatSettingsCmd(map[string]any{
+				"slow_mode":           true,
+				"slow_mode_wait_time": wait,
+			}), nil
+		},
+	})
+	registerCommand(command{
+		name:  "slowoff",
+		usage: "/slowoff",
+		help:  "Turn off slow mode",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			return m.updateChatSettingsCmd(map[string]any{"slow_mode": false}), nil
+		},
+	})
+	registerCommand(command{
+		name:  "emoteonly",
+		usage: "/emoteonly",
+		help:  "Only allow messages made entirely of emotes",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			return m.updateChatSettingsCmd(map[string]any{"emote_mode": true}), nil
+		},
+	})
+	registerCommand(command{
+		name:  "emoteonlyoff",
+		usage: "/emoteonlyoff",
+		help:  "Turn off emote-only mode",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			return m.updateChatSettingsCmd(map[string]any{"emote_mode": false}), nil
+		},
+	})
+	registerCommand(command{
+		name:  "color",
+		usage: "/color <color>",
+		help:  "Change your name color, e.g. blue or #9146FF for Turbo and Prime users",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			color := args[0]
+			return func() tea.Msg {
+				if err := services.UpdateUserChatColor(m.httpClient, m.accessToken, m.loggedInUser, color); err != nil {
+					return ActionDone{err: err}
+				}
+				return ActionDone{notice: "Your color was changed to " + color}
+			}, nil
+		},
+	})
+}
+
+// parseCommand splits chat input into a command name and its arguments.
+// ok is false when the input is not a command and should be sent as chat.
+func parseCommand(input string) (name string, args []string, ok bool) {
+	if !strings.HasPrefix(input, "/") {
+		return "", nil, false
+	}
+	fields := strings.Fields(input[1:])
+	if len(fields) == 0 {
+		return "", nil, false
+	}
+	return strings.ToLower(fields[0]), fields[1:], true
+}
+
+// runCommand runs a slash command, reporting unknown commands and bad
+// arguments in the chat.
+func (m *ChatModel) runCommand(name string, args []string) tea.Cmd {
+	c, ok := commands[name]
+	if !ok {
+		m.addNotice(RenderError(fmt.Sprintf("Unknown command /%s. Type /help for a list of commands.", name)))
+		return nil
+	}
+
+	cmd, err := c.run(m, args)
+	if errors.Is(err, errUsage) {
+		m.addNotice(RenderError("Usage: " + c.usage))
+		return nil
+	}
+	if err != nil {
+		m.addNotice(RenderError(err.Error()))
+		m.addNotice(RenderError("Usage: " + c.usage))
+		return nil
+	}
+	return cmd
+}
+
+func unbanCommand(m *ChatModel, args []string) (tea.Cmd, error) {
+	if len(args) != 1 {
+		return nil, errUsage
+	}
+	return m.withUser(args[0], func(user services.UserInfo) tea.Cmd {
+		return m.unbanCmd(user.ID, user.DisplayName)
+	}), nil
+}
+
+// withUser looks up a user by login name, with or without a leading @,
+// then runs the command returned by fn.
+func (m *ChatModel) withUser(login string, fn func(user services.UserInfo) tea.Cmd) tea.Cmd {
+	login = strings.ToLower(strings.TrimPrefix(login, "@"))
+	return func() tea.Msg {
+		users, err := services.GetUsersByLogin(m.httpClient, m.accessToken, login)
+		if err != nil {
+			return ActionDone{err: err}
+		}
+		if len(users) == 0 {
+			return ActionDone{err: fmt.Errorf("no user named %s", login)}
+		}
+		if cmd := fn(users[0]); cmd != nil {
+			return cmd()
+		}
+		return nil
+	}
+}
+
+// parseDurationSeconds reads a number of seconds, or a duration such as 10m or 1h.
+func parseDurationSeconds(s string) (int, error) {
+	if seconds, err := strconv.Atoi(s); err == nil {
+		return seconds, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	return int(d.Seconds()), nil
+}
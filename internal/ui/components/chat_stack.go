@@ -3,6 +3,7 @@ package components
 import (
 	"strings"
 
+	"charm.land/lipgloss/v2"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -17,8 +18,13 @@ type ChatStack struct {
 
 	// The messages ordered most-to-least recent
 	messages []string
+
+	// The 1-based index of the selected message, 0 when nothing is selected
+	selected int
 }
 
+var selectedStyle = lipgloss.NewStyle().Reverse(true)
+
 func New() ChatStack {
 	return ChatStack{}
 }
@@ -51,7 +57,10 @@ func (cs ChatStack) View() string {
 
 	topFill := strings.Repeat("\n", max(0, cs.height-len(cs.messages)))
 
-	for _, msg := range cs.messages[offset : offset+limit] {
+	for i, msg := range cs.messages[offset : offset+limit] {
+		if offset+i+1 == cs.selected {
+			msg = selectedStyle.Render(msg)
+		}
 		b.WriteString(msg)
 		b.WriteString("\n")
 	}
@@ -66,6 +75,15 @@ func (cs *ChatStack) AddMessage(msg string) {
 	cs.messages = append(cs.messages, msg)
 }
 
+// SetMessage replaces the message at idx, oldest first, e.g. to restyle
+// a message that was deleted. Out of range indexes are ignored.
+func (cs *ChatStack) SetMessage(idx int, msg string) {
+	if idx < 0 || idx >= len(cs.messages) {
+		return
+	}
+	cs.messages[idx] = msg
+}
+
 func (cs *ChatStack) SetWidth(width int) {
 	cs.width = width
 }
@@ -81,3 +99,52 @@ func (cs *ChatStack) SetHeight(height int) {
 func (cs *ChatStack) Height() int {
 	return cs.height
 }
+
+// SelectPrevious moves the selection to the next older message, starting
+// from the most recent one, and scrolls to keep it visible.
+func (cs *ChatStack) SelectPrevious() {
+	if len(cs.messages) == 0 {
+		return
+	}
+	if cs.selected == 0 {
+		cs.selected = len(cs.messages)
+	} else {
+		cs.selected = max(1, cs.selected-1)
+	}
+	cs.scrollToSelected()
+}
+
+// SelectNext moves the selection to the next newer message, clearing it
+// when moving past the most recent one.
+func (cs *ChatStack) SelectNext() {
+	if cs.selected == 0 {
+		return
+	}
+	cs.selected++
+	if cs.selected > len(cs.messages) {
+		cs.selected = 0
+		return
+	}
+	cs.scrollToSelected()
+}
+
+// ClearSelection deselects the selected message.
+func (cs *ChatStack) ClearSelection() {
+	cs.selected = 0
+}
+
+// Selected returns the index of the selected message and whether a
+// message is selected at all.
+func (cs *ChatStack) Selected() (int, bool) {
+	return cs.selected - 1, cs.selected > 0
+}
+
+func (cs *ChatStack) scrollToSelected() {
+	idx := cs.selected - 1
+	if idx < cs.msgOffset {
+		cs.msgOffset = idx
+	}
+	if cs.height > 0 && idx >= cs.msgOffset+cs.height {
+		cs.msgOffset = idx - cs.height + 1
+	}
+}
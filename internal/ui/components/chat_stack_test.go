@@ -142,3 +142,56 @@ func Test_ScrollUpThenDown(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func Test_SelectPreviousStartsAtMostRecent(t *testing.T) {
+	stack := ChatStack{height: 5, width: 5, messages: []string{"hello", "world"}}
+
+	stack.SelectPrevious()
+
+	idx, ok := stack.Selected()
+	if !ok || idx != 1 {
+		t.Fatalf("expected message 1 selected, got %d (selected=%v)", idx, ok)
+	}
+}
+
+func Test_SelectNextPastMostRecentClears(t *testing.T) {
+	stack := ChatStack{height: 5, width: 5, messages: []string{"hello", "world"}}
+
+	stack.SelectPrevious()
+	stack.SelectPrevious()
+	stack.SelectNext()
+	stack.SelectNext()
+
+	if _, ok := stack.Selected(); ok {
+		t.Fail()
+	}
+}
+
+func Test_SelectPreviousScrollsIntoView(t *testing.T) {
+	stack := ChatStack{height: 2, width: 5, messages: []string{"hello", "world", "foo", "bar"}, msgOffset: 2}
+
+	stack.SelectPrevious()
+	stack.SelectPrevious()
+	stack.SelectPrevious()
+
+	idx, _ := stack.Selected()
+	if idx != 1 || stack.msgOffset != 1 {
+		t.Fatalf("expected selection 1 at offset 1, got %d at offset %d", idx, stack.msgOffset)
+	}
+}
+
+func Test_SetMessage(t *testing.T) {
+	stack := ChatStack{height: 5, width: 5, messages: []string{"hello", "world"}}
+
+	stack.SetMessage(0, "deleted")
+	stack.SetMessage(2, "ignored")
+
+	rendered := stack.View()
+	expected := strings.Repeat("\n", 3) + "deleted\nworld"
+
+	if rendered != expected {
+		fmt.Printf("Rendered: %s\n===\n", rendered)
+		fmt.Printf("Expected: %s\n\n", expected)
+		t.Fail()
+	}
+}
@@ -1,11 +1,21 @@
 package components
 
 type Participant struct {
-	Name    string
-	IsPrime bool
+	ID          string
+	Login       string
+	Name        string
+	IsPrime     bool
+	IsModerator bool
+	IsVIP       bool
 }
 
 func (p Participant) Title() string {
+	switch {
+	case p.IsModerator:
+		return "⚔ " + p.Name
+	case p.IsVIP:
+		return "◆ " + p.Name
+	}
 	return p.Name
 }
 
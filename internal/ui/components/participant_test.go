@@ -0,0 +1,24 @@
+package components
+
+import "testing"
+
+func Test_ParticipantTitle(t *testing.T) {
+	tests := []struct {
+		name        string
+		participant Participant
+		expected    string
+	}{
+		{"chatter", Participant{Name: "viewer"}, "viewer"},
+		{"moderator", Participant{Name: "mod", IsModerator: true}, "⚔ mod"},
+		{"vip", Participant{Name: "vip", IsVIP: true}, "◆ vip"},
+		{"moderator and vip", Participant{Name: "both", IsModerator: true, IsVIP: true}, "⚔ both"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.participant.Title(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package ui
+
+import (
+	"log"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// EmotesLoaded carries the emotes the logged-in user can use in the chat.
+type EmotesLoaded struct {
+	emotes []services.Emote
+}
+
+// loadEmotesCmd reads the global, channel and user emotes once so the
+// picker does not have to. Whatever fails to load is logged and left out.
+func (m *ChatModel) loadEmotesCmd() tea.Cmd {
+	return func() tea.Msg {
+		var emotes []services.Emote
+		global, err := services.GetGlobalEmotes(m.httpClient, m.accessToken)
+		if err != nil {
+			log.Println(err)
+		}
+		emotes = append(emotes, global...)
+
+		channel, err := services.GetChannelEmotes(m.httpClient, m.accessToken, m.loggedInUser)
+		if err != nil {
+			log.Println(err)
+		}
+		emotes = append(emotes, channel...)
+
+		cursor := ""
+		for {
+			page, next, err := services.GetUserEmotes(m.httpClient, m.accessToken, m.loggedInUser, cursor)
+			if err != nil {
+				log.Println(err)
+				break
+			}
+			emotes = append(emotes, page...)
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+		return EmotesLoaded{emotes: emotes}
+	}
+}
+
+// setEmotes keeps one emote per name, sorted by name.
+func (m *ChatModel) setEmotes(msg EmotesLoaded) {
+	seen := map[string]bool{}
+	m.emotes = nil
+	for _, e := range msg.emotes {
+		if seen[e.Name] {
+			continue
+		}
+		seen[e.Name] = true
+		m.emotes = append(m.emotes, e)
+	}
+	slices.SortFunc(m.emotes, func(a, b services.Emote) int {
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+}
+
+// renderEmote renders an emote's code in text, e.g. ":Kappa:".
+func renderEmote(name string) string {
+	return EmoteStyle.Render(":" + name + ":")
+}
+
+// insertEmote adds the emote's code to what has been typed in the input.
+func (m *ChatModel) insertEmote(name string) tea.Cmd {
+	text := m.input.Value()
+	if text != "" && !strings.HasSuffix(text, " ") {
+		text += " "
+	}
+	return m.startInput(text + name + " ")
+}
+
+// emotePicker lists the emotes the user can use, filtered as they type,
+// and inserts the chosen one into the chat input.
+type emotePicker struct {
+	filter   textinput.Model
+	selected int
+}
+
+func newEmotePicker() (*emotePicker, tea.Cmd) {
+	p := &emotePicker{filter: textinput.New()}
+	p.filter.Placeholder = "search emotes"
+	return p, p.filter.Focus()
+}
+
+func (p *emotePicker) matches(m *ChatModel) []services.Emote {
+	query := strings.ToLower(strings.TrimSpace(p.filter.Value()))
+	if query == "" {
+		return m.emotes
+	}
+	var emotes []services.Emote
+	for _, e := range m.emotes {
+		if strings.Contains(strings.ToLower(e.Name), query) {
+			emotes = append(emotes, e)
+		}
+	}
+	return emotes
+}
+
+func (p *emotePicker) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	emotes := p.matches(m)
+	switch key.String() {
+	case "up":
+		p.selected = max(0, p.selected-1)
+		return nil
+	case "down":
+		p.selected = max(0, min(len(emotes)-1, p.selected+1))
+		return nil
+	case "enter":
+		if len(emotes) == 0 {
+			return nil
+		}
+		m.closeOverlay()
+		return m.insertEmote(emotes[min(p.selected, len(emotes)-1)].Name)
+	}
+
+	var cmd tea.Cmd
+	p.filter, cmd = p.filter.Update(key)
+	p.selected = 0
+	return cmd
+}
+
+func (p *emotePicker) View(m *ChatModel, width, height int) string {
+	emotes := p.matches(m)
+	p.filter.SetWidth(width)
+	lines := []string{Header("Emotes"), "", p.filter.View(), ""}
+	if len(emotes) == 0 {
+		return strings.Join(append(lines, MutedStyle.Render("No matching emotes")), "\n")
+	}
+
+	// Scroll to keep the selected emote in view
+	selected := min(p.selected, len(emotes)-1)
+	visible := max(1, height-len(lines))
+	start := max(0, selected-visible+1)
+	for i := start; i < len(emotes) && i < start+visible; i++ {
+		line := renderEmote(emotes[i].Name) + " " + MutedStyle.Render(emoteKind(emotes[i]))
+		if i == selected {
+			line = LabelStyle.Render("> ") + line
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *emotePicker) Help() string {
+	return "type: search   up/down: select   enter: insert   esc: close"
+}
+
+// emoteKind describes where an emote comes from, e.g. "Tier 1 sub".
+func emoteKind(e services.Emote) string {
+	switch e.EmoteType {
+	case "", "globals", "smilies":
+		return "global"
+	case "subscriptions":
+		if e.Tier != "" {
+			return formatTier(e.Tier) + " sub"
+		}
+		return "sub"
+	case "bitstier":
+		return "bits"
+	}
+	return e.EmoteType
+}
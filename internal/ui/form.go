@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"strings"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+)
+
+type formField struct {
+	label string
+	input textinput.Model
+}
+
+// form collects several lines of text, such as the title and cost of a
+// new reward, before running onSubmit with them.
+type form struct {
+	title   string
+	fields  []formField
+	focused int
+	err     error
+	// onSubmit receives the values in field order. Returning an error
+	// keeps the form open with the error shown.
+	onSubmit func(m *ChatModel, values []string) (tea.Cmd, error)
+}
+
+func newForm(title string, labels []string, onSubmit func(m *ChatModel, values []string) (tea.Cmd, error)) (*form, tea.Cmd) {
+	f := &form{title: title, onSubmit: onSubmit}
+	for _, label := range labels {
+		f.fields = append(f.fields, formField{label: label, input: textinput.New()})
+	}
+	return f, f.fields[0].input.Focus()
+}
+
+// setValue fills in a field before the form is shown.
+func (f *form) setValue(field int, value string) {
+	f.fields[field].input.SetValue(value)
+	f.fields[field].input.CursorEnd()
+}
+
+func (f *form) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.String() {
+		case "tab", "down":
+			return f.focus((f.focused + 1) % len(f.fields))
+		case "shift+tab", "up":
+			return f.focus((f.focused + len(f.fields) - 1) % len(f.fields))
+		case "enter":
+			if f.focused < len(f.fields)-1 {
+				return f.focus(f.focused + 1)
+			}
+			values := make([]string, len(f.fields))
+			for i, field := range f.fields {
+				values[i] = strings.TrimSpace(field.input.Value())
+			}
+			cmd, err := f.onSubmit(m, values)
+			if err != nil {
+				f.err = err
+				return nil
+			}
+			m.closeOverlay()
+			return cmd
+		}
+	}
+
+	var cmd tea.Cmd
+	f.fields[f.focused].input, cmd = f.fields[f.focused].input.Update(msg)
+	return cmd
+}
+
+func (f *form) focus(field int) tea.Cmd {
+	f.fields[f.focused].input.Blur()
+	f.focused = field
+	return f.fields[f.focused].input.Focus()
+}
+
+func (f *form) View(m *ChatModel, width, height int) string {
+	lines := []string{Header(f.title), ""}
+	labelWidth := 0
+	for _, field := range f.fields {
+		labelWidth = max(labelWidth, len(field.label)+1)
+	}
+	for i := range f.fields {
+		field := &f.fields[i]
+		field.input.SetWidth(max(1, width-labelWidth-3))
+		lines = append(lines, LabelStyle.Width(labelWidth).Render(field.label+":")+" "+field.input.View())
+	}
+	if f.err != nil {
+		lines = append(lines, "", RenderError(f.err.Error()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (f *form) Help() string {
+	return "tab: next field   enter: next/submit   esc: cancel"
+}
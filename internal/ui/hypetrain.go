@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// How wide the hype train's progress bar is in the stream header.
+const hypeTrainBarWidth = 10
+
+// hypeTrain is the hype train running in the channel.
+type hypeTrain struct {
+	level     int
+	progress  int
+	goal      int
+	expiresAt time.Time
+}
+
+func (m *ChatModel) hypeTrainBegan(event services.HypeTrainEvent) {
+	m.hypeTrainProgressed(event)
+	m.addNotice(Notice("A hype train has started!"))
+}
+
+func (m *ChatModel) hypeTrainProgressed(event services.HypeTrainEvent) {
+	if m.stream.hypeTrain != nil && event.Level > m.stream.hypeTrain.level && m.stream.hypeTrain.level > 0 {
+		m.addNotice(Notice(fmt.Sprintf("The hype train reached level %d!", event.Level)))
+	}
+	m.stream.hypeTrain = &hypeTrain{
+		level:    event.Level,
+		progress: event.Progress,
+		goal:     event.Goal,
+	}
+	if event.ExpiresAt != nil {
+		m.stream.hypeTrain.expiresAt = *event.ExpiresAt
+	}
+}
+
+func (m *ChatModel) hypeTrainEnded(event services.HypeTrainEvent) {
+	m.stream.hypeTrain = nil
+	notice := fmt.Sprintf("The hype train ended at level %d", event.Level)
+	if len(event.TopContributions) > 0 {
+		top := event.TopContributions[0]
+		notice += fmt.Sprintf(", led by %s", top.UserName)
+	}
+	m.addNotice(Notice(notice))
+}
+
+// header renders the hype train's level, progress towards the next level
+// and time left, e.g. "Hype Lv2 ████░░░░░░ 40% 3m12s".
+func (h *hypeTrain) header() string {
+	percent := 0
+	if h.goal > 0 {
+		percent = h.progress * 100 / h.goal
+	}
+	text := HypeTrainStyle.Render(fmt.Sprintf("Hype Lv%d", h.level)) + " " + ProgressBar(percent, hypeTrainBarWidth) + fmt.Sprintf(" %d%%", percent)
+	if !h.expiresAt.IsZero() {
+		text += " " + formatUptime(max(0, time.Until(h.expiresAt)))
+	}
+	return text
+}
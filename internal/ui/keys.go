@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// chatKeys describes the keys available in the chat room while the input
+// is unfocused, in the order the keys panel lists them.
+var chatKeys = []struct {
+	key  string
+	help string
+}{
+	{"tab", "toggle input"},
+	{"c", "toggle chatters"},
+	{"enter", "info on the selected chatter"},
+	{"[ / ]", "select an older / newer message"},
+	{"u", "info on the selected message's author"},
+	{"d", "delete the selected message"},
+	{"t", "time out the selected message's author"},
+	{"b", "ban the selected message's author"},
+	{"s", "chat settings"},
+	{"a", "alert and raid toggles"},
+	{"w", "whispers"},
+	{"l", "bits leaderboard"},
+	{"r", "channel points redemptions"},
+	{"p", "polls"},
+	{"o", "predictions"},
+	{"m", "moderation log"},
+	{"h", "AutoMod held messages and settings"},
+	{"k", "blocked terms"},
+	{"q", "unban requests"},
+	{"v", "moderators and VIPs"},
+	{"e", "edit the title, category, tags and language"},
+	{"x", "clip the stream"},
+	{"X", "clips created this session"},
+	{"f", "add a stream marker, described by the selected message"},
+	{"!", "toggle shield mode"},
+	{"B", "show badges as glyphs, labels or not at all"},
+	{"ctrl+e", "emotes, also while typing"},
+	{"?", "this list"},
+	{"esc", "logout"},
+}
+
+// keysPanel lists the chat room's keybindings.
+type keysPanel struct{}
+
+func (p *keysPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	return nil
+}
+
+func (p *keysPanel) View(m *ChatModel, width, height int) string {
+	lines := []string{Header("Keys"), ""}
+	for _, k := range chatKeys {
+		lines = append(lines, LabelStyle.Width(8).Render(k.key)+" "+k.help)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *keysPanel) Help() string {
+	return "esc: close"
+}
@@ -50,6 +50,38 @@ var scopes []string = []string{
 	"user:read:chat",
 	"user:write:chat",
 	"channel:read:subscriptions",
+	"moderator:read:followers",
+	"moderator:manage:banned_users",
+	"moderator:manage:chat_messages",
+	"moderator:manage:chat_settings",
+	"moderator:manage:announcements",
+	"moderator:manage:shoutouts",
+	"channel:manage:raids",
+	"user:manage:chat_color",
+	"user:manage:whispers",
+	"bits:read",
+	"channel:manage:redemptions",
+	"channel:manage:polls",
+	"channel:manage:predictions",
+	"channel:read:hype_train",
+	"channel:read:ads",
+	"channel:manage:ads",
+	"channel:edit:commercial",
+	"moderator:read:blocked_terms",
+	"moderator:manage:unban_requests",
+	"moderator:read:suspicious_users",
+	"moderator:manage:warnings",
+	"moderator:read:moderators",
+	"moderator:read:vips",
+	"channel:manage:moderators",
+	"channel:manage:vips",
+	"clips:edit",
+	"user:read:emotes",
+	"channel:manage:broadcast",
+	"moderator:manage:automod",
+	"moderator:manage:automod_settings",
+	"moderator:manage:blocked_terms",
+	"moderator:manage:shield_mode",
 }
 
 // LoginSuccessMsg is sent when Twitch login succeeds and carries the access token.
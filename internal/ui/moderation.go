@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// How long a timeout lasts when no duration is given, in seconds.
+const defaultTimeoutSeconds = 600
+
+// Twitch's limits on a timeout, in seconds. Zero would make it a ban.
+const (
+	minTimeoutSeconds = 1
+	maxTimeoutSeconds = 1209600
+)
+
+// promptBan asks for a reason before banning the user, or timing them
+// out when duration is above zero.
+func (m *ChatModel) promptBan(userID, name string, duration int) tea.Cmd {
+	question := fmt.Sprintf("Ban %s?", name)
+	if duration > 0 {
+		question = fmt.Sprintf("Time out %s for %ds?", name, duration)
+	}
+	return m.openOverlay(newInputPrompt(question, "Reason (optional)", func(m *ChatModel, reason string) tea.Cmd {
+		return m.banCmd(userID, name, duration, reason)
+	}))
+}
+
+func (m *ChatModel) promptUnban(userID, name string) tea.Cmd {
+	return m.openOverlay(newConfirmPrompt(fmt.Sprintf("Unban %s?", name), func(m *ChatModel) tea.Cmd {
+		return m.unbanCmd(userID, name)
+	}))
+}
+
+func (m *ChatModel) promptDelete(line chatLine) tea.Cmd {
+	question := fmt.Sprintf("Delete %s's message \"%s\"?", line.name, line.text)
+	return m.openOverlay(newConfirmPrompt(question, func(m *ChatModel) tea.Cmd {
+		return m.deleteMessageCmd(line)
+	}))
+}
+
+// banCmd bans the user, or times them out when duration is above zero.
+func (m *ChatModel) banCmd(userID, name string, duration int, reason string) tea.Cmd {
+	return func() tea.Msg {
+		err := services.BanUser(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, userID, duration, reason)
+		if err != nil {
+			return ActionDone{err: err}
+		}
+		if duration > 0 {
+			return ActionDone{notice: fmt.Sprintf("%s was timed out for %ds", name, duration)}
+		}
+		return ActionDone{notice: name + " was banned"}
+	}
+}
+
+func (m *ChatModel) unbanCmd(userID, name string) tea.Cmd {
+	return func() tea.Msg {
+		err := services.UnbanUser(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, userID)
+		if err != nil {
+			return ActionDone{err: err}
+		}
+		return ActionDone{notice: name + " was unbanned"}
+	}
+}
+
+// deleteMessageCmd deletes the line's message. Lines without a message ID
+// are left alone, as deleting an empty ID would clear the whole chat.
+func (m *ChatModel) deleteMessageCmd(line chatLine) tea.Cmd {
+	if line.messageID == "" {
+		return nil
+	}
+	return func() tea.Msg {
+		err := services.DeleteChatMessages(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, line.messageID)
+		if err != nil {
+			return ActionDone{err: err}
+		}
+		return ActionDone{notice: line.name + "'s message was deleted"}
+	}
+}
+
+// clearChatCmd deletes every message in the chat.
+func (m *ChatModel) clearChatCmd() tea.Cmd {
+	return func() tea.Msg {
+		err := services.DeleteChatMessages(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, "")
+		if err != nil {
+			return ActionDone{err: err}
+		}
+		return ActionDone{notice: "Chat was cleared"}
+	}
+}
@@ -0,0 +1,213 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// How many moderation actions the moderation log keeps.
+const modLogSize = 500
+
+// modLogEntry is a moderation action taken in the channel.
+type modLogEntry struct {
+	at        time.Time
+	moderator string
+	userID    string // The user the action was taken against, if any
+	text      string
+}
+
+// addModeration records a channel.moderate action in the moderation log.
+func (m *ChatModel) addModeration(event services.ModerateEvent) {
+	m.modLog = append(m.modLog, modLogEntry{
+		at:        time.Now(),
+		moderator: event.ModeratorUserName,
+		userID:    moderationTarget(event),
+		text:      describeModeration(event),
+	})
+	if len(m.modLog) > modLogSize {
+		m.modLog = m.modLog[len(m.modLog)-modLogSize:]
+	}
+}
+
+// describeModeration renders what a moderation action did, without who did it.
+func describeModeration(e services.ModerateEvent) string {
+	switch e.Action {
+	case "ban":
+		if e.Ban != nil {
+			return "banned " + e.Ban.UserName + withReason(e.Ban.Reason)
+		}
+	case "unban":
+		if e.Unban != nil {
+			return "unbanned " + e.Unban.UserName
+		}
+	case "timeout":
+		if e.Timeout != nil {
+			d := time.Until(e.Timeout.ExpiresAt).Round(time.Second)
+			return fmt.Sprintf("timed out %s for %s%s", e.Timeout.UserName, humanizeDuration(max(time.Minute, d)), withReason(e.Timeout.Reason))
+		}
+	case "untimeout":
+		if e.Untimeout != nil {
+			return "removed the timeout on " + e.Untimeout.UserName
+		}
+	case "delete":
+		if e.Delete != nil {
+			return fmt.Sprintf("deleted a message from %s: %s", e.Delete.UserName, e.Delete.MessageBody)
+		}
+	case "clear":
+		return "cleared chat"
+	case "vip":
+		if e.VIP != nil {
+			return "added " + e.VIP.UserName + " as a VIP"
+		}
+	case "unvip":
+		if e.Unvip != nil {
+			return "removed " + e.Unvip.UserName + " as a VIP"
+		}
+	case "mod":
+		if e.Mod != nil {
+			return "made " + e.Mod.UserName + " a moderator"
+		}
+	case "unmod":
+		if e.Unmod != nil {
+			return "removed " + e.Unmod.UserName + " as a moderator"
+		}
+	case "raid":
+		if e.Raid != nil {
+			return fmt.Sprintf("started a raid to %s with %d viewers", e.Raid.UserName, e.Raid.ViewerCount)
+		}
+	case "unraid":
+		if e.Unraid != nil {
+			return "canceled the raid to " + e.Unraid.UserName
+		}
+	case "followers":
+		if e.Followers != nil && e.Followers.FollowDurationMinutes > 0 {
+			return fmt.Sprintf("turned on follower-only mode (followed for %dm)", e.Followers.FollowDurationMinutes)
+		}
+		return "turned on follower-only mode"
+	case "slow":
+		if e.Slow != nil {
+			return fmt.Sprintf("turned on slow mode (%ds)", e.Slow.WaitTimeSeconds)
+		}
+		return "turned on slow mode"
+	case "emoteonly", "subscribers", "uniquechat":
+		return "turned on " + modeNames[e.Action]
+	case "followersoff", "slowoff", "emoteonlyoff", "subscribersoff", "uniquechatoff":
+		return "turned off " + modeNames[strings.TrimSuffix(e.Action, "off")]
+	case "add_blocked_term", "add_permitted_term", "remove_blocked_term", "remove_permitted_term":
+		if e.AutomodTerms != nil {
+			verb := "added"
+			if e.AutomodTerms.Action == "remove" {
+				verb = "removed"
+			}
+			return fmt.Sprintf("%s %s term %s", verb, e.AutomodTerms.List, strings.Join(e.AutomodTerms.Terms, ", "))
+		}
+	case "approve_unban_request", "deny_unban_request":
+		if e.UnbanRequest != nil {
+			verb := "denied"
+			if e.UnbanRequest.IsApproved {
+				verb = "approved"
+			}
+			return fmt.Sprintf("%s the unban request from %s%s", verb, e.UnbanRequest.UserName, withReason(e.UnbanRequest.ModeratorMessage))
+		}
+	case "warn":
+		if e.Warn != nil {
+			return "warned " + e.Warn.UserName + withReason(e.Warn.Reason)
+		}
+	}
+	return strings.ReplaceAll(e.Action, "_", " ")
+}
+
+// moderationTarget returns the ID of the user a moderation action was
+// taken against, empty for actions such as mode changes.
+func moderationTarget(e services.ModerateEvent) string {
+	switch {
+	case e.Ban != nil:
+		return e.Ban.UserID
+	case e.Timeout != nil:
+		return e.Timeout.UserID
+	case e.Delete != nil:
+		return e.Delete.UserID
+	case e.Warn != nil:
+		return e.Warn.UserID
+	case e.UnbanRequest != nil:
+		return e.UnbanRequest.UserID
+	}
+	for _, u := range []*services.ModeratedUser{e.Unban, e.Untimeout, e.VIP, e.Unvip, e.Mod, e.Unmod} {
+		if u != nil {
+			return u.UserID
+		}
+	}
+	return ""
+}
+
+// modeNames names the chat modes channel.moderate actions turn on and off.
+var modeNames = map[string]string{
+	"followers":   "follower-only mode",
+	"slow":        "slow mode",
+	"emoteonly":   "emote-only mode",
+	"subscribers": "subscriber-only mode",
+	"uniquechat":  "unique chat mode",
+}
+
+func withReason(reason string) string {
+	if reason == "" {
+		return ""
+	}
+	return ": " + reason
+}
+
+// clearUserMessages greys out a banned or timed out user's messages.
+func (m *ChatModel) clearUserMessages(event services.ClearUserMessagesEvent) {
+	m.restyleLines(func(line chatLine) bool {
+		return line.userID == event.TargetUserID && line.messageID != ""
+	}, MutedStyle)
+}
+
+// messageDeleted strikes through a deleted message.
+func (m *ChatModel) messageDeleted(event services.MessageDeleteEvent) {
+	m.restyleLines(func(line chatLine) bool {
+		return line.messageID == event.MessageID
+	}, DeletedStyle)
+}
+
+// restyleLines re-renders the chat lines that match in a plain style,
+// keeping everything shown on them but their colors.
+func (m *ChatModel) restyleLines(match func(chatLine) bool, style lipgloss.Style) {
+	for i, line := range m.history {
+		if match(line) {
+			m.history[i].rendered = style.Render(ansi.Strip(line.rendered))
+			m.chat.SetMessage(i, m.history[i].rendered)
+		}
+	}
+}
+
+// modLogPanel lists the moderation actions taken since the chat opened,
+// most recent last.
+type modLogPanel struct{}
+
+func (p *modLogPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	return nil
+}
+
+func (p *modLogPanel) View(m *ChatModel, width, height int) string {
+	lines := []string{Header("Moderation log"), ""}
+	if len(m.modLog) == 0 {
+		lines = append(lines, MutedStyle.Render("No moderation actions yet"))
+	}
+	// Show the most recent actions that fit
+	entries := m.modLog[max(0, len(m.modLog)-max(0, height-len(lines))):]
+	for _, e := range entries {
+		lines = append(lines, MutedStyle.Render(e.at.Format("15:04"))+" "+LabelStyle.Render(e.moderator)+" "+e.text)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *modLogPanel) Help() string {
+	return "esc: close"
+}
@@ -0,0 +1,311 @@
+package ui
+
+import (
+	"log"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// handleNotification applies an EventSub notification to the chat.
+func (m *ChatModel) handleNotification(n services.Notification) tea.Cmd {
+	switch n.Payload.Subscription.Type {
+	case "channel.chat.message":
+		event, err := services.DecodeEvent[services.ChatMessageEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.addChatMessage(event)
+	case "channel.chat_settings.update":
+		event, err := services.DecodeEvent[services.ChatSettingsUpdateEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		settings := event.ChatSettings()
+		m.chatSettings = &settings
+	case "channel.chat.notification":
+		event, err := services.DecodeEvent[services.ChatNotificationEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		if event.NoticeType == "announcement" {
+			m.addAnnouncement(event)
+		} else {
+			m.addChatNotificationAlert(event)
+		}
+	case "channel.shoutout.create":
+		event, err := services.DecodeEvent[services.ShoutoutCreateEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.addShoutoutCreated(event)
+	case "channel.shoutout.receive":
+		event, err := services.DecodeEvent[services.ShoutoutReceiveEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.addShoutoutReceived(event)
+	case "user.whisper.message":
+		event, err := services.DecodeEvent[services.WhisperMessageEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.addWhisper(event)
+	case "stream.online":
+		event, err := services.DecodeEvent[services.StreamOnlineEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.streamOnline(event)
+		return m.loadAdScheduleIfLive()
+	case "stream.offline":
+		m.streamOffline()
+	case "channel.update":
+		event, err := services.DecodeEvent[services.ChannelUpdateEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.channelUpdated(event)
+	case "channel.follow":
+		event, err := services.DecodeEvent[services.FollowEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.addFollowAlert(event)
+	case "channel.subscribe":
+		event, err := services.DecodeEvent[services.SubscribeEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.addSubscribeAlert(event)
+	case "channel.subscription.gift":
+		event, err := services.DecodeEvent[services.SubscriptionGiftEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.addGiftAlert(event)
+	case "channel.subscription.message":
+		event, err := services.DecodeEvent[services.SubscriptionMessageEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.addResubAlert(event)
+	case "channel.cheer":
+		// The cheer itself is shown from its chat message, marked with its bits
+		return m.refreshLeaderboard()
+	case "channel.channel_points_custom_reward_redemption.add":
+		event, err := services.DecodeEvent[services.RedemptionAddEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.addRedemption(event)
+	case "channel.poll.begin", "channel.poll.progress", "channel.poll.end":
+		event, err := services.DecodeEvent[services.PollEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		switch n.Payload.Subscription.Type {
+		case "channel.poll.begin":
+			m.pollBegan(event)
+		case "channel.poll.progress":
+			m.pollProgressed(event)
+		case "channel.poll.end":
+			m.pollEnded(event)
+		}
+	case "channel.prediction.begin", "channel.prediction.progress", "channel.prediction.lock", "channel.prediction.end":
+		event, err := services.DecodeEvent[services.PredictionEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.predictionUpdated(n.Payload.Subscription.Type, event)
+	case "channel.raid":
+		event, err := services.DecodeEvent[services.RaidEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		return m.addRaid(event)
+	case "channel.hype_train.begin", "channel.hype_train.progress", "channel.hype_train.end":
+		event, err := services.DecodeEvent[services.HypeTrainEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		switch n.Payload.Subscription.Type {
+		case "channel.hype_train.begin":
+			m.hypeTrainBegan(event)
+		case "channel.hype_train.progress":
+			m.hypeTrainProgressed(event)
+		case "channel.hype_train.end":
+			m.hypeTrainEnded(event)
+		}
+	case "channel.ad_break.begin":
+		event, err := services.DecodeEvent[services.AdBreakBeginEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		return m.adBreakBegan(event)
+	case "channel.moderate":
+		event, err := services.DecodeEvent[services.ModerateEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.addModeration(event)
+	case "channel.chat.clear_user_messages":
+		event, err := services.DecodeEvent[services.ClearUserMessagesEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.clearUserMessages(event)
+	case "channel.chat.message_delete":
+		event, err := services.DecodeEvent[services.MessageDeleteEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.messageDeleted(event)
+	case "automod.message.hold", "automod.message.update":
+		event, err := services.DecodeEvent[services.AutoModMessageEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		if n.Payload.Subscription.Type == "automod.message.hold" {
+			m.holdMessage(event)
+		} else {
+			m.heldMessageUpdated(event)
+		}
+	case "channel.unban_request.create":
+		event, err := services.DecodeEvent[services.UnbanRequestCreateEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.addUnbanRequest(event)
+	case "channel.unban_request.resolve":
+		event, err := services.DecodeEvent[services.UnbanRequestResolveEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.unbanRequestResolved(event)
+	case "channel.shield_mode.begin", "channel.shield_mode.end":
+		event, err := services.DecodeEvent[services.ShieldModeEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.shieldModeChanged(n.Payload.Subscription.Type == "channel.shield_mode.begin", event)
+	case "channel.suspicious_user.message":
+		event, err := services.DecodeEvent[services.SuspiciousUserMessageEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.suspiciousMessage(event)
+	case "channel.suspicious_user.update":
+		event, err := services.DecodeEvent[services.SuspiciousUserUpdateEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.suspiciousUserUpdated(event)
+	case "channel.warning.send":
+		event, err := services.DecodeEvent[services.WarningSendEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.warningSent(event)
+	case "channel.warning.acknowledge":
+		event, err := services.DecodeEvent[services.WarningAcknowledgeEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.warningAcknowledged(event)
+	case "channel.moderator.add", "channel.moderator.remove":
+		event, err := services.DecodeEvent[services.RoleChangeEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.roleChanged(roleModerator, n.Payload.Subscription.Type == "channel.moderator.add", event)
+	case "channel.vip.add", "channel.vip.remove":
+		event, err := services.DecodeEvent[services.RoleChangeEvent](n)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		m.roleChanged(roleVIP, n.Payload.Subscription.Type == "channel.vip.add", event)
+	}
+	return nil
+}
+
+// eventSubscriptions lists the EventSub subscriptions the chat listens to.
+func (m *ChatModel) eventSubscriptions() []map[string]any {
+	return []map[string]any{
+		services.ChannelChatMessageSub(m.loggedInUser, m.sessionID),
+		services.ChannelChatSettingsUpdateSub(m.loggedInUser, m.sessionID),
+		services.ChannelChatNotificationSub(m.loggedInUser, m.sessionID),
+		services.ChannelShoutoutCreateSub(m.loggedInUser, m.sessionID),
+		services.ChannelShoutoutReceiveSub(m.loggedInUser, m.sessionID),
+		services.UserWhisperMessageSub(m.loggedInUser, m.sessionID),
+		services.StreamOnlineSub(m.loggedInUser, m.sessionID),
+		services.StreamOfflineSub(m.loggedInUser, m.sessionID),
+		services.ChannelUpdateSub(m.loggedInUser, m.sessionID),
+		services.ChannelFollowSub(m.loggedInUser, m.sessionID),
+		services.ChannelSubscribeSub(m.loggedInUser, m.sessionID),
+		services.ChannelSubscriptionGiftSub(m.loggedInUser, m.sessionID),
+		services.ChannelSubscriptionMessageSub(m.loggedInUser, m.sessionID),
+		services.ChannelCheerSub(m.loggedInUser, m.sessionID),
+		services.ChannelPointsRedemptionAddSub(m.loggedInUser, m.sessionID),
+		services.ChannelPollBeginSub(m.loggedInUser, m.sessionID),
+		services.ChannelPollProgressSub(m.loggedInUser, m.sessionID),
+		services.ChannelPollEndSub(m.loggedInUser, m.sessionID),
+		services.ChannelPredictionBeginSub(m.loggedInUser, m.sessionID),
+		services.ChannelPredictionProgressSub(m.loggedInUser, m.sessionID),
+		services.ChannelPredictionLockSub(m.loggedInUser, m.sessionID),
+		services.ChannelPredictionEndSub(m.loggedInUser, m.sessionID),
+		services.ChannelRaidSub(m.loggedInUser, m.sessionID),
+		services.ChannelHypeTrainBeginSub(m.loggedInUser, m.sessionID),
+		services.ChannelHypeTrainProgressSub(m.loggedInUser, m.sessionID),
+		services.ChannelHypeTrainEndSub(m.loggedInUser, m.sessionID),
+		services.ChannelAdBreakBeginSub(m.loggedInUser, m.sessionID),
+		services.ChannelModerateSub(m.loggedInUser, m.sessionID),
+		services.ChannelChatClearUserMessagesSub(m.loggedInUser, m.sessionID),
+		services.ChannelChatMessageDeleteSub(m.loggedInUser, m.sessionID),
+		services.AutoModMessageHoldSub(m.loggedInUser, m.sessionID),
+		services.AutoModMessageUpdateSub(m.loggedInUser, m.sessionID),
+		services.ChannelUnbanRequestCreateSub(m.loggedInUser, m.sessionID),
+		services.ChannelUnbanRequestResolveSub(m.loggedInUser, m.sessionID),
+		services.ChannelShieldModeBeginSub(m.loggedInUser, m.sessionID),
+		services.ChannelShieldModeEndSub(m.loggedInUser, m.sessionID),
+		services.ChannelSuspiciousUserMessageSub(m.loggedInUser, m.sessionID),
+		services.ChannelSuspiciousUserUpdateSub(m.loggedInUser, m.sessionID),
+		services.ChannelWarningSendSub(m.loggedInUser, m.sessionID),
+		services.ChannelWarningAcknowledgeSub(m.loggedInUser, m.sessionID),
+		services.ChannelModeratorAddSub(m.loggedInUser, m.sessionID),
+		services.ChannelModeratorRemoveSub(m.loggedInUser, m.sessionID),
+		services.ChannelVIPAddSub(m.loggedInUser, m.sessionID),
+		services.ChannelVIPRemoveSub(m.loggedInUser, m.sessionID),
+	}
+}
@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+	"github.com/stretchr/testify/assert"
+)
+
+// notification builds an EventSub notification of the subscription type
+// carrying the event.
+func notification(t *testing.T, subType, event string) services.Notification {
+	t.Helper()
+	n, err := services.ParseNotification([]byte(fmt.Sprintf(`{
+		"metadata": {"message_type": "notification", "subscription_type": %q, "subscription_version": "1"},
+		"payload": {"subscription": {"type": %q, "version": "1"}, "event": %s}
+	}`, subType, subType, event)))
+	assert.NoError(t, err)
+	return n
+}
+
+func newTestChatModel() *ChatModel {
+	m := NewChatModel(nil, "token")
+	m.loggedInUser = "1"
+	return m
+}
+
+const roleChangeEvent = `{"broadcaster_user_id": "1", "broadcaster_user_login": "streamer", "broadcaster_user_name": "Streamer",
+	"user_id": "2", "user_login": "helper", "user_name": "Helper"}`
+
+func TestHandleNotification(t *testing.T) {
+	tests := []struct {
+		name    string
+		subType string
+		event   string
+		setup   func(m *ChatModel)
+		check   func(t *testing.T, m *ChatModel)
+	}{
+		{
+			name:    "chat notification with its own alert",
+			subType: "channel.chat.notification",
+			event: `{"broadcaster_user_id": "1", "chatter_user_id": "2", "chatter_user_login": "fan", "chatter_user_name": "Fan",
+				"message_id": "msg-1", "system_message": "Fan subscribed at Tier 1.", "notice_type": "sub",
+				"message": {"text": "", "fragments": []}}`,
+			check: func(t *testing.T, m *ChatModel) {
+				assert.Empty(t, m.history)
+			},
+		},
+		{
+			name:    "chat notification without its own alert",
+			subType: "channel.chat.notification",
+			event: `{"broadcaster_user_id": "1", "chatter_user_id": "2", "chatter_user_login": "fan", "chatter_user_name": "Fan",
+				"message_id": "msg-1", "system_message": "Fan earned a new Bits badge.", "notice_type": "bits_badge_tier",
+				"message": {"text": "", "fragments": []}}`,
+			check: func(t *testing.T, m *ChatModel) {
+				assert.Len(t, m.history, 1)
+			},
+		},
+		{
+			name:    "suspicious user message from a restricted user",
+			subType: "channel.suspicious_user.message",
+			event: `{"broadcaster_user_id": "1", "user_id": "2", "user_login": "troll", "user_name": "Troll",
+				"low_trust_status": "restricted", "types": ["manually_added"], "ban_evasion_evaluation": "unknown",
+				"message": {"message_id": "msg-1", "text": "hello", "fragments": [{"type": "text", "text": "hello"}]}}`,
+			check: func(t *testing.T, m *ChatModel) {
+				assert.Equal(t, lowTrustRestricted, m.lowTrust["2"])
+				if assert.Len(t, m.history, 1) {
+					assert.Equal(t, "msg-1", m.history[0].messageID)
+					assert.Equal(t, lowTrustRestricted, m.history[0].lowTrust)
+				}
+			},
+		},
+		{
+			name:    "suspicious user update",
+			subType: "channel.suspicious_user.update",
+			event: `{"broadcaster_user_id": "1", "moderator_user_id": "3", "moderator_user_login": "mod", "moderator_user_name": "Mod",
+				"user_id": "2", "user_login": "troll", "user_name": "Troll", "low_trust_status": "active_monitoring"}`,
+			check: func(t *testing.T, m *ChatModel) {
+				assert.Equal(t, lowTrustMonitored, m.lowTrust["2"])
+				assert.Len(t, m.history, 1)
+			},
+		},
+		{
+			name:    "warning sent",
+			subType: "channel.warning.send",
+			event: `{"broadcaster_user_id": "1", "moderator_user_id": "3", "moderator_user_login": "mod", "moderator_user_name": "Mod",
+				"user_id": "2", "user_login": "troll", "user_name": "Troll", "reason": "spam", "chat_rules_cited": null}`,
+			check: func(t *testing.T, m *ChatModel) {
+				if assert.NotNil(t, m.warnings["2"]) {
+					assert.Equal(t, "spam", m.warnings["2"].reason)
+					assert.Equal(t, "Mod", m.warnings["2"].moderator)
+					assert.False(t, m.warnings["2"].acknowledged)
+				}
+			},
+		},
+		{
+			name:    "warning acknowledged",
+			subType: "channel.warning.acknowledge",
+			event:   `{"broadcaster_user_id": "1", "user_id": "2", "user_login": "troll", "user_name": "Troll"}`,
+			setup: func(m *ChatModel) {
+				m.warnings["2"] = &warning{reason: "spam", moderator: "Mod"}
+			},
+			check: func(t *testing.T, m *ChatModel) {
+				assert.True(t, m.warnings["2"].acknowledged)
+				assert.Len(t, m.history, 1)
+			},
+		},
+		{
+			name:    "moderator added",
+			subType: "channel.moderator.add",
+			event:   roleChangeEvent,
+			check: func(t *testing.T, m *ChatModel) {
+				assert.True(t, m.moderators["2"])
+				assert.False(t, m.vips["2"])
+			},
+		},
+		{
+			name:    "moderator removed",
+			subType: "channel.moderator.remove",
+			event:   roleChangeEvent,
+			setup: func(m *ChatModel) {
+				m.moderators["2"] = true
+			},
+			check: func(t *testing.T, m *ChatModel) {
+				assert.False(t, m.moderators["2"])
+			},
+		},
+		{
+			name:    "VIP added",
+			subType: "channel.vip.add",
+			event:   roleChangeEvent,
+			check: func(t *testing.T, m *ChatModel) {
+				assert.True(t, m.vips["2"])
+				assert.False(t, m.moderators["2"])
+			},
+		},
+		{
+			name:    "VIP removed",
+			subType: "channel.vip.remove",
+			event:   roleChangeEvent,
+			setup: func(m *ChatModel) {
+				m.vips["2"] = true
+			},
+			check: func(t *testing.T, m *ChatModel) {
+				assert.False(t, m.vips["2"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestChatModel()
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			m.handleNotification(notification(t, tt.subType, tt.event))
+
+			tt.check(t, m)
+		})
+	}
+}
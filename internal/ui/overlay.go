@@ -0,0 +1,36 @@
+package ui
+
+import (
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// overlay is a panel drawn over the chat room. While open it receives
+// every key press, and any message the ChatModel does not handle itself.
+type overlay interface {
+	Update(m *ChatModel, msg tea.Msg) tea.Cmd
+	View(m *ChatModel, width, height int) string
+	// Help is the keybinding hint shown in the footer.
+	Help() string
+}
+
+// openOverlay shows o over the chat room, returning its first command.
+func (m *ChatModel) openOverlay(o overlay, cmd tea.Cmd) tea.Cmd {
+	m.overlay = o
+	return cmd
+}
+
+// closeOverlay hides the open overlay and returns to the chat room.
+func (m *ChatModel) closeOverlay() {
+	m.overlay = nil
+}
+
+func (m *ChatModel) overlayView(height int) string {
+	width := m.Width - OverlayStyle.GetHorizontalFrameSize()
+	innerHeight := height - OverlayStyle.GetVerticalFrameSize()
+	content := m.overlay.View(m, width, innerHeight)
+	box := OverlayStyle.Width(m.Width - OverlayStyle.GetHorizontalMargins()).
+		Height(height - OverlayStyle.GetVerticalMargins()).
+		Render(content)
+	return lipgloss.PlaceHorizontal(m.Width, lipgloss.Left, box)
+}
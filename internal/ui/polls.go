@@ -0,0 +1,227 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// Twitch accepts polls of 2 to 5 choices lasting 15 seconds to 30 minutes.
+const (
+	maxPollChoices      = 5
+	minPollDuration     = 15
+	maxPollDuration     = 1800
+	defaultPollDuration = "2m"
+)
+
+// Poll statuses used by the End Poll endpoint and the channel.poll.end event.
+const (
+	pollActive     = "ACTIVE"
+	pollTerminated = "TERMINATED"
+	pollArchived   = "ARCHIVED"
+)
+
+// pollState is the most recent poll in the channel, kept up to date by
+// the channel.poll.* notifications.
+type pollState struct {
+	id      string
+	title   string
+	choices []services.PollChoice
+	status  string
+	endsAt  time.Time
+}
+
+// PollLoaded carries a poll read, created or ended through the Twitch API.
+// poll is nil when the channel has no polls.
+type PollLoaded struct {
+	poll *services.Poll
+	err  error
+}
+
+func (m *ChatModel) loadPollCmd() tea.Cmd {
+	return func() tea.Msg {
+		polls, err := services.GetPolls(m.httpClient, m.accessToken, m.loggedInUser)
+		if err != nil || len(polls) == 0 {
+			return PollLoaded{err: err}
+		}
+		return PollLoaded{poll: &polls[0]}
+	}
+}
+
+func (m *ChatModel) endPollCmd(status string) tea.Cmd {
+	if m.poll == nil || m.poll.status != pollActive {
+		return nil
+	}
+	id := m.poll.id
+	return func() tea.Msg {
+		poll, err := services.EndPoll(m.httpClient, m.accessToken, m.loggedInUser, id, status)
+		return PollLoaded{poll: poll, err: err}
+	}
+}
+
+func (m *ChatModel) pollLoaded(msg PollLoaded) {
+	if msg.err != nil {
+		m.addNotice(RenderError(msg.err.Error()))
+		return
+	}
+	if msg.poll == nil {
+		return
+	}
+	m.poll = &pollState{
+		id:      msg.poll.ID,
+		title:   msg.poll.Title,
+		choices: msg.poll.Choices,
+		status:  msg.poll.Status,
+		endsAt:  msg.poll.StartedAt.Add(time.Duration(msg.poll.Duration) * time.Second),
+	}
+}
+
+func (m *ChatModel) pollBegan(event services.PollEvent) {
+	m.pollProgressed(event)
+	m.addNotice(Notice("Poll started: " + event.Title))
+}
+
+func (m *ChatModel) pollProgressed(event services.PollEvent) {
+	m.poll = &pollState{
+		id:      event.ID,
+		title:   event.Title,
+		choices: event.Choices,
+		status:  pollActive,
+	}
+	if event.EndsAt != nil {
+		m.poll.endsAt = *event.EndsAt
+	}
+}
+
+func (m *ChatModel) pollEnded(event services.PollEvent) {
+	m.poll = &pollState{
+		id:      event.ID,
+		title:   event.Title,
+		choices: event.Choices,
+		status:  strings.ToUpper(event.Status),
+	}
+	if event.EndedAt != nil {
+		m.poll.endsAt = *event.EndedAt
+	}
+
+	notice := "Poll ended: " + event.Title
+	if winner, ok := m.poll.winner(); ok {
+		notice += fmt.Sprintf(" - %s won with %d votes", winner.Title, winner.Votes)
+	}
+	m.addNotice(Notice(notice))
+}
+
+// winner returns the choice with the most votes, or false when no one voted.
+func (p *pollState) winner() (services.PollChoice, bool) {
+	var winner services.PollChoice
+	for _, c := range p.choices {
+		if c.Votes > winner.Votes {
+			winner = c
+		}
+	}
+	return winner, winner.Votes > 0
+}
+
+// newPollForm asks for a question, up to five choices and how long the
+// poll runs. Blank choices are left out.
+func newPollForm() (*form, tea.Cmd) {
+	labels := []string{"Question"}
+	for i := range maxPollChoices {
+		labels = append(labels, fmt.Sprintf("Choice %d", i+1))
+	}
+	labels = append(labels, "Duration")
+
+	f, cmd := newForm("New poll", labels, func(m *ChatModel, values []string) (tea.Cmd, error) {
+		title := values[0]
+		if title == "" {
+			return nil, errors.New("the poll needs a question")
+		}
+		var choices []string
+		for _, choice := range values[1 : 1+maxPollChoices] {
+			if choice != "" {
+				choices = append(choices, choice)
+			}
+		}
+		if len(choices) < 2 {
+			return nil, errors.New("the poll needs at least two choices")
+		}
+		duration, err := parseDurationSeconds(values[len(values)-1])
+		if err != nil || duration < minPollDuration || duration > maxPollDuration {
+			return nil, errors.New("the duration must be between 15s and 30m")
+		}
+		return func() tea.Msg {
+			poll, err := services.CreatePoll(m.httpClient, m.accessToken, m.loggedInUser, title, choices, duration)
+			return PollLoaded{poll: poll, err: err}
+		}, nil
+	})
+	f.setValue(len(labels)-1, defaultPollDuration)
+	return f, cmd
+}
+
+// pollPanel shows the current or most recent poll as a bar chart.
+type pollPanel struct{}
+
+func newPollPanel(m *ChatModel) (*pollPanel, tea.Cmd) {
+	if m.poll == nil {
+		return &pollPanel{}, m.loadPollCmd()
+	}
+	return &pollPanel{}, nil
+}
+
+func (p *pollPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	switch key.String() {
+	case "n":
+		return m.openOverlay(newPollForm())
+	case "e":
+		return m.endPollCmd(pollTerminated)
+	case "x":
+		return m.endPollCmd(pollArchived)
+	}
+	return nil
+}
+
+func (p *pollPanel) View(m *ChatModel, width, height int) string {
+	if m.poll == nil {
+		return Header("Poll") + "\n\n" + MutedStyle.Render("No polls yet. Press n to start one.")
+	}
+
+	state := MutedStyle.Render("ended")
+	if m.poll.status == pollActive {
+		state = LiveStyle.Render("● ") + formatUptime(max(0, time.Until(m.poll.endsAt))) + " left"
+	}
+	lines := []string{Header("Poll: "+m.poll.title) + "  " + state, ""}
+
+	total, titleWidth := 0, 0
+	for _, c := range m.poll.choices {
+		total += c.Votes
+		titleWidth = max(titleWidth, len(c.Title))
+	}
+	winner, hasWinner := m.poll.winner()
+	// Leave room for the title, the vote count and the percentage
+	barWidth := max(1, width-titleWidth-16)
+	for _, c := range m.poll.choices {
+		percent := 0
+		if total > 0 {
+			percent = c.Votes * 100 / total
+		}
+		title := fmt.Sprintf("%-*s", titleWidth, c.Title)
+		if m.poll.status != pollActive && hasWinner && c.ID == winner.ID {
+			title = LabelStyle.Render(title)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %5d %3d%%", title, ProgressBar(percent, barWidth), c.Votes, percent))
+	}
+	lines = append(lines, "", MutedStyle.Render(fmt.Sprintf("%d votes", total)))
+	return strings.Join(lines, "\n")
+}
+
+func (p *pollPanel) Help() string {
+	return "n: new poll   e: end poll   x: end and hide results   esc: close"
+}
@@ -0,0 +1,281 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// Twitch accepts predictions of 2 to 10 outcomes that take predictions
+// for 30 seconds to 30 minutes. The form offers the first five outcomes.
+const (
+	maxPredictionOutcomes   = 5
+	minPredictionWindow     = 30
+	maxPredictionWindow     = 1800
+	defaultPredictionWindow = "2m"
+	// How many of an outcome's top predictors the panel lists
+	shownTopPredictors = 3
+)
+
+// Prediction statuses used by the End Prediction endpoint and the
+// channel.prediction.* events.
+const (
+	predictionActive   = "ACTIVE"
+	predictionLocked   = "LOCKED"
+	predictionResolved = "RESOLVED"
+	predictionCanceled = "CANCELED"
+)
+
+// predictionState is the most recent prediction in the channel, kept up
+// to date by the channel.prediction.* notifications.
+type predictionState struct {
+	id               string
+	title            string
+	outcomes         []services.PredictionOutcome
+	status           string
+	locksAt          time.Time
+	winningOutcomeID string
+}
+
+// PredictionLoaded carries a prediction read, created or ended through the
+// Twitch API. prediction is nil when the channel has no predictions.
+type PredictionLoaded struct {
+	prediction *services.Prediction
+	err        error
+}
+
+func (m *ChatModel) loadPredictionCmd() tea.Cmd {
+	return func() tea.Msg {
+		predictions, err := services.GetPredictions(m.httpClient, m.accessToken, m.loggedInUser)
+		if err != nil || len(predictions) == 0 {
+			return PredictionLoaded{err: err}
+		}
+		return PredictionLoaded{prediction: &predictions[0]}
+	}
+}
+
+func (m *ChatModel) endPredictionCmd(status, winningOutcomeID string) tea.Cmd {
+	id := m.prediction.id
+	return func() tea.Msg {
+		prediction, err := services.EndPrediction(m.httpClient, m.accessToken, m.loggedInUser, id, status, winningOutcomeID)
+		return PredictionLoaded{prediction: prediction, err: err}
+	}
+}
+
+func (m *ChatModel) predictionLoaded(msg PredictionLoaded) {
+	if msg.err != nil {
+		m.addNotice(RenderError(msg.err.Error()))
+		return
+	}
+	if msg.prediction == nil {
+		return
+	}
+	p := msg.prediction
+	m.prediction = &predictionState{
+		id:               p.ID,
+		title:            p.Title,
+		outcomes:         p.Outcomes,
+		status:           p.Status,
+		locksAt:          p.CreatedAt.Add(time.Duration(p.PredictionWindow) * time.Second),
+		winningOutcomeID: p.WinningOutcomeID,
+	}
+}
+
+// predictionUpdated applies a channel.prediction.* event, announcing the
+// prediction starting, locking and ending in chat.
+func (m *ChatModel) predictionUpdated(subType string, event services.PredictionEvent) {
+	status := strings.ToUpper(event.Status)
+	switch subType {
+	case "channel.prediction.begin", "channel.prediction.progress":
+		status = predictionActive
+	case "channel.prediction.lock":
+		status = predictionLocked
+	}
+	m.prediction = &predictionState{
+		id:               event.ID,
+		title:            event.Title,
+		outcomes:         event.Outcomes,
+		status:           status,
+		winningOutcomeID: event.WinningOutcomeID,
+	}
+	if event.LocksAt != nil {
+		m.prediction.locksAt = *event.LocksAt
+	}
+
+	switch subType {
+	case "channel.prediction.begin":
+		m.addNotice(Notice("Prediction started: " + event.Title))
+	case "channel.prediction.lock":
+		m.addNotice(Notice("Predictions are locked: " + event.Title))
+	case "channel.prediction.end":
+		if status == predictionCanceled {
+			m.addNotice(Notice("Prediction canceled and points refunded: " + event.Title))
+		} else if winner, ok := m.prediction.outcome(event.WinningOutcomeID); ok {
+			m.addNotice(Notice(fmt.Sprintf("Prediction resolved: %s - %s won %d points for %d users",
+				event.Title, winner.Title, m.prediction.totalPoints(), winner.Users)))
+		}
+	}
+}
+
+func (p *predictionState) outcome(id string) (services.PredictionOutcome, bool) {
+	for _, o := range p.outcomes {
+		if o.ID == id {
+			return o, true
+		}
+	}
+	return services.PredictionOutcome{}, false
+}
+
+func (p *predictionState) totalPoints() int {
+	total := 0
+	for _, o := range p.outcomes {
+		total += o.ChannelPoints
+	}
+	return total
+}
+
+// newPredictionForm asks for a question, up to five outcomes and how long
+// viewers may predict. Blank outcomes are left out.
+func newPredictionForm() (*form, tea.Cmd) {
+	labels := []string{"Question"}
+	for i := range maxPredictionOutcomes {
+		labels = append(labels, fmt.Sprintf("Outcome %d", i+1))
+	}
+	labels = append(labels, "Window")
+
+	f, cmd := newForm("New prediction", labels, func(m *ChatModel, values []string) (tea.Cmd, error) {
+		title := values[0]
+		if title == "" {
+			return nil, errors.New("the prediction needs a question")
+		}
+		var outcomes []string
+		for _, outcome := range values[1 : 1+maxPredictionOutcomes] {
+			if outcome != "" {
+				outcomes = append(outcomes, outcome)
+			}
+		}
+		if len(outcomes) < 2 {
+			return nil, errors.New("the prediction needs at least two outcomes")
+		}
+		window, err := parseDurationSeconds(values[len(values)-1])
+		if err != nil || window < minPredictionWindow || window > maxPredictionWindow {
+			return nil, errors.New("the window must be between 30s and 30m")
+		}
+		return func() tea.Msg {
+			prediction, err := services.CreatePrediction(m.httpClient, m.accessToken, m.loggedInUser, title, outcomes, window)
+			return PredictionLoaded{prediction: prediction, err: err}
+		}, nil
+	})
+	f.setValue(len(labels)-1, defaultPredictionWindow)
+	return f, cmd
+}
+
+// predictionPanel shows the current or most recent prediction's outcomes
+// with the points on each and their top predictors. Predictions started
+// elsewhere can have up to ten outcomes, so the winner is picked from a
+// list rather than by number.
+type predictionPanel struct {
+	selected int
+}
+
+func newPredictionPanel(m *ChatModel) (*predictionPanel, tea.Cmd) {
+	if m.prediction == nil {
+		return &predictionPanel{}, m.loadPredictionCmd()
+	}
+	return &predictionPanel{}, nil
+}
+
+func (p *predictionPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	if key.String() == "n" {
+		return m.openOverlay(newPredictionForm())
+	}
+	if m.prediction == nil {
+		return nil
+	}
+	open := m.prediction.status == predictionActive || m.prediction.status == predictionLocked
+
+	switch key.String() {
+	case "l":
+		if m.prediction.status == predictionActive {
+			return m.endPredictionCmd(predictionLocked, "")
+		}
+	case "x":
+		if open {
+			return m.openOverlay(newConfirmPrompt("Cancel the prediction and refund everyone's points?", func(m *ChatModel) tea.Cmd {
+				return m.endPredictionCmd(predictionCanceled, "")
+			}))
+		}
+	case "up":
+		p.selected = max(0, p.selected-1)
+	case "down":
+		p.selected = min(max(0, len(m.prediction.outcomes)-1), p.selected+1)
+	case "enter":
+		if !open || len(m.prediction.outcomes) == 0 {
+			return nil
+		}
+		o := m.prediction.outcomes[min(p.selected, len(m.prediction.outcomes)-1)]
+		return m.openOverlay(newConfirmPrompt(fmt.Sprintf("Resolve the prediction with %q winning?", o.Title), func(m *ChatModel) tea.Cmd {
+			return m.endPredictionCmd(predictionResolved, o.ID)
+		}))
+	}
+	return nil
+}
+
+func (p *predictionPanel) View(m *ChatModel, width, height int) string {
+	pr := m.prediction
+	if pr == nil {
+		return Header("Prediction") + "\n\n" + MutedStyle.Render("No predictions yet. Press n to start one.")
+	}
+
+	var state string
+	switch pr.status {
+	case predictionActive:
+		state = LiveStyle.Render("● ") + formatUptime(max(0, time.Until(pr.locksAt))) + " to predict"
+	case predictionLocked:
+		state = MutedStyle.Render("locked")
+	default:
+		state = MutedStyle.Render(strings.ToLower(pr.status))
+	}
+	lines := []string{Header("Prediction: "+pr.title) + "  " + state, ""}
+
+	total := pr.totalPoints()
+	selected := min(p.selected, len(pr.outcomes)-1)
+	for i, o := range pr.outcomes {
+		percent, ratio := 0, "-"
+		if total > 0 {
+			percent = o.ChannelPoints * 100 / total
+		}
+		if o.ChannelPoints > 0 {
+			ratio = fmt.Sprintf("1:%.2f", float64(total)/float64(o.ChannelPoints))
+		}
+		title := o.Title
+		if o.ID == pr.winningOutcomeID {
+			title = LabelStyle.Render(title + " ✓")
+		}
+		if i == selected {
+			title = LabelStyle.Render("> ") + title
+		} else {
+			title = "  " + title
+		}
+		lines = append(lines, title)
+		lines = append(lines, fmt.Sprintf("    %s %3d%%  %s points  %d users  %s",
+			ProgressBar(percent, max(1, min(30, width-50))), percent,
+			CheerStyle.Render(fmt.Sprintf("%d", o.ChannelPoints)), o.Users, MutedStyle.Render(ratio)))
+		for _, predictor := range o.TopPredictors[:min(shownTopPredictors, len(o.TopPredictors))] {
+			lines = append(lines, MutedStyle.Render(fmt.Sprintf("      %s %d", predictor.UserName, predictor.ChannelPointsUsed)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *predictionPanel) Help() string {
+	return "n: new   l: lock   up/down: select outcome   enter: resolve with outcome   x: cancel   esc: close"
+}
@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+)
+
+// prompt asks the user to confirm an action, optionally collecting
+// free text such as a moderation reason first.
+type prompt struct {
+	question string
+	input    textinput.Model
+	hasInput bool
+	onSubmit func(m *ChatModel, value string) tea.Cmd
+}
+
+// newConfirmPrompt asks a yes/no question before running onConfirm.
+func newConfirmPrompt(question string, onConfirm func(m *ChatModel) tea.Cmd) (*prompt, tea.Cmd) {
+	return &prompt{
+		question: question,
+		onSubmit: func(m *ChatModel, _ string) tea.Cmd { return onConfirm(m) },
+	}, nil
+}
+
+// newInputPrompt collects a line of text before running onSubmit with it.
+// The text may be left empty.
+func newInputPrompt(question, placeholder string, onSubmit func(m *ChatModel, value string) tea.Cmd) (*prompt, tea.Cmd) {
+	p := &prompt{
+		question: question,
+		input:    textinput.New(),
+		hasInput: true,
+		onSubmit: onSubmit,
+	}
+	p.input.Placeholder = placeholder
+	return p, p.input.Focus()
+}
+
+func (p *prompt) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.String() == "enter", !p.hasInput && key.String() == "y":
+			m.closeOverlay()
+			return p.onSubmit(m, p.input.Value())
+		case !p.hasInput && key.String() == "n":
+			m.closeOverlay()
+			return nil
+		}
+	}
+	if !p.hasInput {
+		return nil
+	}
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	return cmd
+}
+
+func (p *prompt) View(m *ChatModel, width, height int) string {
+	view := Header(p.question)
+	if p.hasInput {
+		p.input.SetWidth(width)
+		view += "\n\n" + p.input.View()
+	}
+	return view
+}
+
+func (p *prompt) Help() string {
+	if p.hasInput {
+		return "enter: confirm   esc: cancel"
+	}
+	return "y/enter: confirm   n/esc: cancel"
+}
@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// While raid protection is on, chat is follower-only for a few minutes
+// after a raid, and only to users who have followed for a while.
+const (
+	raidProtectionDuration        = 5 * time.Minute
+	raidProtectionFollowerMinutes = 10
+)
+
+// raidSettings is what happens when another channel raids, set in the
+// alerts panel.
+type raidSettings struct {
+	shoutout   bool
+	protection bool
+	// When the follower-only mode turned on by raid protection ends, zero
+	// when it is not on
+	protectedUntil time.Time
+}
+
+// RaidProtectionEnded is sent when raid protection may turn follower-only
+// mode back off.
+type RaidProtectionEnded struct{}
+
+func init() {
+	registerCommand(command{
+		name:  "raid",
+		usage: "/raid <channel>",
+		help:  "Send your viewers to another channel",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			return m.withUser(args[0], func(user services.UserInfo) tea.Cmd {
+				return func() tea.Msg {
+					if _, err := services.StartRaid(m.httpClient, m.accessToken, m.loggedInUser, user.ID); err != nil {
+						return ActionDone{err: err}
+					}
+					return ActionDone{notice: "Raiding " + user.DisplayName + " in 90 seconds"}
+				}
+			}), nil
+		},
+	})
+	registerCommand(command{
+		name:  "unraid",
+		usage: "/unraid",
+		help:  "Cancel a pending raid",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			return func() tea.Msg {
+				if err := services.CancelRaid(m.httpClient, m.accessToken, m.loggedInUser); err != nil {
+					return ActionDone{err: err}
+				}
+				return ActionDone{notice: "The raid was canceled"}
+			}, nil
+		},
+	})
+}
+
+// addRaid renders an incoming raid, then shouts out the raider and turns
+// on raid protection if they are set up.
+func (m *ChatModel) addRaid(event services.RaidEvent) tea.Cmd {
+	line := chatLine{userID: event.FromBroadcasterUserID, login: event.FromBroadcasterUserLogin, name: event.FromBroadcasterUserName}
+	m.addAlert(alertRaid, line, fmt.Sprintf("%s is raiding with %d viewers!", event.FromBroadcasterUserName, event.Viewers))
+
+	var cmds []tea.Cmd
+	if m.raid.shoutout {
+		cmds = append(cmds, m.shoutoutCmd(event.FromBroadcasterUserID))
+	}
+	if m.raid.protection {
+		cmds = append(cmds, m.protectFromRaid())
+	}
+	return tea.Batch(cmds...)
+}
+
+// protectFromRaid makes chat follower-only for raidProtectionDuration,
+// extending the protection if it is already on. Follower-only mode the
+// broadcaster turned on themselves is left alone.
+func (m *ChatModel) protectFromRaid() tea.Cmd {
+	alreadyOn := m.chatSettings != nil && m.chatSettings.FollowerMode
+	if alreadyOn && m.raid.protectedUntil.IsZero() {
+		return nil
+	}
+
+	m.raid.protectedUntil = time.Now().Add(raidProtectionDuration)
+	m.addNotice(Notice(fmt.Sprintf("Raid protection: chat is follower-only for %s", humanizeDuration(raidProtectionDuration))))
+	end := tea.Tick(raidProtectionDuration, func(time.Time) tea.Msg { return RaidProtectionEnded{} })
+	if alreadyOn {
+		return end
+	}
+	return tea.Batch(end, m.updateChatSettingsCmd(map[string]any{
+		"follower_mode":          true,
+		"follower_mode_duration": raidProtectionFollowerMinutes,
+	}))
+}
+
+// endRaidProtection turns follower-only mode off once the latest raid's
+// protection has run its course.
+func (m *ChatModel) endRaidProtection() tea.Cmd {
+	if m.raid.protectedUntil.IsZero() || time.Now().Before(m.raid.protectedUntil) {
+		return nil
+	}
+	m.raid.protectedUntil = time.Time{}
+	m.addNotice(Notice("Raid protection ended"))
+	return m.updateChatSettingsCmd(map[string]any{"follower_mode": false})
+}
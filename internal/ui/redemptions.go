@@ -0,0 +1,249 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// Redemption statuses accepted by the Update Redemption Status endpoint.
+const (
+	redemptionFulfilled = "FULFILLED"
+	redemptionCanceled  = "CANCELED"
+)
+
+// RedemptionUpdated reports the outcome of fulfilling or canceling a redemption.
+type RedemptionUpdated struct {
+	redemption services.RedemptionAddEvent
+	status     string
+	err        error
+}
+
+// CustomRewardsLoaded carries the channel's custom rewards and the IDs of
+// those this app may manage. Twitch only lets an app update redemptions of
+// rewards it created.
+type CustomRewardsLoaded struct {
+	rewards    []services.CustomReward
+	manageable map[string]bool
+	err        error
+}
+
+// addRedemption announces a redemption in chat and queues it for the
+// broadcaster to fulfill or cancel, unless it skipped the request queue.
+func (m *ChatModel) addRedemption(event services.RedemptionAddEvent) {
+	text := fmt.Sprintf("%s redeemed %s (%d points)", event.UserName, event.Reward.Title, event.Reward.Cost)
+	if event.UserInput != "" {
+		text += ": " + event.UserInput
+	}
+	line := chatLine{userID: event.UserID, login: event.UserLogin, name: event.UserName}
+	m.addAlert(alertRedemption, line, text)
+
+	if strings.EqualFold(event.Status, "unfulfilled") {
+		m.redemptions = append(m.redemptions, event)
+	}
+}
+
+func (m *ChatModel) updateRedemptionCmd(redemption services.RedemptionAddEvent, status string) tea.Cmd {
+	return func() tea.Msg {
+		err := services.UpdateRedemptionStatus(m.httpClient, m.accessToken, m.loggedInUser, redemption.Reward.ID, redemption.ID, status)
+		return RedemptionUpdated{redemption: redemption, status: status, err: err}
+	}
+}
+
+// redemptionUpdated removes a fulfilled or canceled redemption from the queue.
+func (m *ChatModel) redemptionUpdated(msg RedemptionUpdated) {
+	if msg.err != nil {
+		m.addNotice(RenderError(msg.err.Error()))
+		return
+	}
+	m.removeRedemption(msg.redemption.ID)
+	verb := "Fulfilled"
+	if msg.status == redemptionCanceled {
+		verb = "Canceled and refunded"
+	}
+	m.addNotice(Notice(fmt.Sprintf("%s %s for %s", verb, msg.redemption.Reward.Title, msg.redemption.UserName)))
+}
+
+func (m *ChatModel) removeRedemption(id string) {
+	for i, r := range m.redemptions {
+		if r.ID == id {
+			m.redemptions = append(m.redemptions[:i], m.redemptions[i+1:]...)
+			return
+		}
+	}
+}
+
+func (m *ChatModel) loadCustomRewardsCmd() tea.Cmd {
+	return func() tea.Msg {
+		rewards, err := services.GetCustomRewards(m.httpClient, m.accessToken, m.loggedInUser, false)
+		if err != nil {
+			return CustomRewardsLoaded{err: err}
+		}
+		manageable, err := services.GetCustomRewards(m.httpClient, m.accessToken, m.loggedInUser, true)
+		if err != nil {
+			return CustomRewardsLoaded{err: err}
+		}
+		ids := map[string]bool{}
+		for _, r := range manageable {
+			ids[r.ID] = true
+		}
+		return CustomRewardsLoaded{rewards: rewards, manageable: ids}
+	}
+}
+
+// newRewardForm asks for the title, cost and optional prompt of a new reward.
+func newRewardForm() (*form, tea.Cmd) {
+	return newForm("New reward", []string{"Title", "Cost", "Prompt"}, func(m *ChatModel, values []string) (tea.Cmd, error) {
+		title, prompt := values[0], values[2]
+		if title == "" {
+			return nil, errors.New("the reward needs a title")
+		}
+		cost, err := strconv.Atoi(values[1])
+		if err != nil || cost < 1 {
+			return nil, errors.New("the cost must be a whole number of points")
+		}
+		return func() tea.Msg {
+			reward, err := services.CreateCustomReward(m.httpClient, m.accessToken, m.loggedInUser, title, cost, prompt)
+			if err != nil {
+				return ActionDone{err: err}
+			}
+			return ActionDone{notice: fmt.Sprintf("Created the reward %s for %d points", reward.Title, reward.Cost)}
+		}, nil
+	})
+}
+
+// redemptionsPanel is the queue of redemptions waiting to be fulfilled or
+// canceled, and can switch to a list of the channel's rewards.
+type redemptionsPanel struct {
+	selected    int
+	showRewards bool
+	rewards     []services.CustomReward
+	// manageable holds the IDs of the rewards this app may update
+	// redemptions of, or is nil until the rewards have loaded
+	manageable map[string]bool
+	rewardsErr error
+	loaded     bool
+}
+
+func newRedemptionsPanel(m *ChatModel) (*redemptionsPanel, tea.Cmd) {
+	return &redemptionsPanel{}, m.loadCustomRewardsCmd()
+}
+
+// readOnly reports whether the reward was created outside this app, so
+// its redemptions can only be fulfilled or canceled on Twitch.
+func (p *redemptionsPanel) readOnly(rewardID string) bool {
+	return p.manageable != nil && !p.manageable[rewardID]
+}
+
+func (p *redemptionsPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case CustomRewardsLoaded:
+		p.rewards, p.rewardsErr, p.loaded = msg.rewards, msg.err, true
+		if msg.err == nil {
+			p.manageable = msg.manageable
+		}
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up":
+			p.selected = max(0, p.selected-1)
+		case "down":
+			p.selected = min(max(0, len(m.redemptions)-1), p.selected+1)
+		case "f", "x":
+			if p.showRewards || len(m.redemptions) == 0 {
+				return nil
+			}
+			r := m.redemptions[min(p.selected, len(m.redemptions)-1)]
+			if p.readOnly(r.Reward.ID) {
+				return nil
+			}
+			status := redemptionFulfilled
+			if msg.String() == "x" {
+				status = redemptionCanceled
+			}
+			return m.updateRedemptionCmd(r, status)
+		case "d":
+			if p.showRewards || len(m.redemptions) == 0 {
+				return nil
+			}
+			m.removeRedemption(m.redemptions[min(p.selected, len(m.redemptions)-1)].ID)
+		case "v":
+			p.showRewards = !p.showRewards
+			if p.showRewards {
+				p.loaded = false
+				return m.loadCustomRewardsCmd()
+			}
+		case "n":
+			return m.openOverlay(newRewardForm())
+		}
+	}
+	return nil
+}
+
+func (p *redemptionsPanel) View(m *ChatModel, width, height int) string {
+	if p.showRewards {
+		return p.rewardsView()
+	}
+	lines := []string{Header(fmt.Sprintf("Redemptions (%d waiting)", len(m.redemptions))), ""}
+	if len(m.redemptions) == 0 {
+		lines = append(lines, MutedStyle.Render("No redemptions waiting"))
+	}
+	selected := min(p.selected, len(m.redemptions)-1)
+	anyReadOnly := false
+	for i, r := range m.redemptions {
+		line := fmt.Sprintf("%s %s %s", MutedStyle.Render(r.RedeemedAt.Local().Format("15:04")), LabelStyle.Render(r.UserName), r.Reward.Title)
+		if r.UserInput != "" {
+			line += ": " + r.UserInput
+		}
+		if p.readOnly(r.Reward.ID) {
+			line += " " + MutedStyle.Render("read-only")
+			anyReadOnly = true
+		}
+		if i == selected {
+			line = LabelStyle.Render("> ") + line
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+	if anyReadOnly {
+		lines = append(lines, "", MutedStyle.Render("Read-only rewards weren't created here and can only be fulfilled on Twitch"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *redemptionsPanel) rewardsView() string {
+	lines := []string{Header("Rewards"), ""}
+	switch {
+	case p.rewardsErr != nil:
+		lines = append(lines, RenderError(p.rewardsErr.Error()))
+	case !p.loaded:
+		lines = append(lines, MutedStyle.Render("Loading…"))
+	case len(p.rewards) == 0:
+		lines = append(lines, MutedStyle.Render("No custom rewards yet"))
+	}
+	for _, r := range p.rewards {
+		line := fmt.Sprintf("%-30s %s", r.Title, CheerStyle.Render(fmt.Sprintf("%d points", r.Cost)))
+		switch {
+		case !r.IsEnabled:
+			line += " " + MutedStyle.Render("disabled")
+		case r.IsPaused:
+			line += " " + MutedStyle.Render("paused")
+		}
+		if p.readOnly(r.ID) {
+			line += " " + MutedStyle.Render("read-only")
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *redemptionsPanel) Help() string {
+	if p.showRewards {
+		return "v: redemptions   n: new reward   esc: close"
+	}
+	return "up/down: select   f: fulfill   x: cancel and refund   d: dismiss   v: rewards   n: new reward   esc: close"
+}
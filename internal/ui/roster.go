@@ -0,0 +1,357 @@
+package ui
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/ui/components"
+)
+
+// channelRole is a role the broadcaster can give users in their channel.
+type channelRole int
+
+const (
+	roleModerator channelRole = iota
+	roleVIP
+)
+
+func (r channelRole) String() string {
+	if r == roleVIP {
+		return "VIP"
+	}
+	return "moderator"
+}
+
+// RolesLoaded carries every moderator and VIP in the channel.
+type RolesLoaded struct {
+	moderators []services.ChannelMember
+	vips       []services.ChannelMember
+	err        error
+}
+
+// RosterPageLoaded carries a page of the channel's moderators or VIPs.
+type RosterPageLoaded struct {
+	role    channelRole
+	page    int
+	members []services.ChannelMember
+	next    string
+	err     error
+}
+
+// RosterChanged reports the outcome of adding or removing a moderator or
+// VIP. Successful changes are announced by their channel.moderator.* and
+// channel.vip.* events.
+type RosterChanged struct {
+	err error
+}
+
+func init() {
+	for _, c := range []struct {
+		name string
+		role channelRole
+		add  bool
+		help string
+	}{
+		{"mod", roleModerator, true, "Make a user a moderator"},
+		{"unmod", roleModerator, false, "Remove a user's moderator role"},
+		{"vip", roleVIP, true, "Make a user a VIP"},
+		{"unvip", roleVIP, false, "Remove a user's VIP role"},
+	} {
+		registerCommand(command{
+			name:  c.name,
+			usage: "/" + c.name + " <user>",
+			help:  c.help,
+			run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+				if len(args) != 1 {
+					return nil, errUsage
+				}
+				return m.withUser(args[0], func(user services.UserInfo) tea.Cmd {
+					return m.changeRoleCmd(c.role, c.add, user.ID)
+				}), nil
+			},
+		})
+	}
+}
+
+// loadRolesCmd reads every moderator and VIP so chatters can be marked
+// with their role.
+func (m *ChatModel) loadRolesCmd() tea.Cmd {
+	return func() tea.Msg {
+		moderators, err := allChannelMembers(func(after string) ([]services.ChannelMember, string, error) {
+			return services.GetModerators(m.httpClient, m.accessToken, m.loggedInUser, after)
+		})
+		if err != nil {
+			return RolesLoaded{err: err}
+		}
+		vips, err := allChannelMembers(func(after string) ([]services.ChannelMember, string, error) {
+			return services.GetVIPs(m.httpClient, m.accessToken, m.loggedInUser, after)
+		})
+		return RolesLoaded{moderators: moderators, vips: vips, err: err}
+	}
+}
+
+func allChannelMembers(getPage func(after string) ([]services.ChannelMember, string, error)) ([]services.ChannelMember, error) {
+	var members []services.ChannelMember
+	cursor := ""
+	for {
+		page, next, err := getPage(cursor)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, page...)
+		if next == "" {
+			return members, nil
+		}
+		cursor = next
+	}
+}
+
+func (m *ChatModel) loadRosterPageCmd(role channelRole, page int, after string) tea.Cmd {
+	return func() tea.Msg {
+		getPage := services.GetModerators
+		if role == roleVIP {
+			getPage = services.GetVIPs
+		}
+		members, next, err := getPage(m.httpClient, m.accessToken, m.loggedInUser, after)
+		return RosterPageLoaded{role: role, page: page, members: members, next: next, err: err}
+	}
+}
+
+// changeRoleCmd gives the user the role, or takes it away when add is false.
+func (m *ChatModel) changeRoleCmd(role channelRole, add bool, userID string) tea.Cmd {
+	return func() tea.Msg {
+		var change func(*http.Client, string, string, string) error
+		switch {
+		case role == roleModerator && add:
+			change = services.AddChannelModerator
+		case role == roleModerator:
+			change = services.RemoveChannelModerator
+		case add:
+			change = services.AddChannelVIP
+		default:
+			change = services.RemoveChannelVIP
+		}
+		return RosterChanged{err: change(m.httpClient, m.accessToken, m.loggedInUser, userID)}
+	}
+}
+
+// refreshRoster reloads the roster if it is on screen.
+func (m *ChatModel) refreshRoster() tea.Cmd {
+	if p, ok := m.overlay.(*rosterPanel); ok {
+		return p.load(m)
+	}
+	return nil
+}
+
+func (m *ChatModel) setRoles(msg RolesLoaded) {
+	if msg.err != nil {
+		log.Println(msg.err)
+		return
+	}
+	m.moderators = map[string]bool{}
+	for _, u := range msg.moderators {
+		m.moderators[u.UserID] = true
+	}
+	m.vips = map[string]bool{}
+	for _, u := range msg.vips {
+		m.vips[u.UserID] = true
+	}
+	m.refreshParticipantRoles()
+}
+
+// roleChanged applies a channel.moderator.add/remove or
+// channel.vip.add/remove event.
+func (m *ChatModel) roleChanged(role channelRole, added bool, event services.RoleChangeEvent) {
+	members := m.moderators
+	if role == roleVIP {
+		members = m.vips
+	}
+	if added {
+		members[event.UserID] = true
+		m.addNotice(Notice(fmt.Sprintf("%s is now a %s", event.UserName, role)))
+	} else {
+		delete(members, event.UserID)
+		m.addNotice(Notice(fmt.Sprintf("%s is no longer a %s", event.UserName, role)))
+	}
+	m.refreshParticipantRoles()
+}
+
+// withRoles marks the participant with their roles in the channel.
+func (m *ChatModel) withRoles(p components.Participant) components.Participant {
+	p.IsModerator = m.moderators[p.ID]
+	p.IsVIP = m.vips[p.ID]
+	return p
+}
+
+func (m *ChatModel) refreshParticipantRoles() {
+	for i, item := range m.participants.Items() {
+		if p, ok := item.(components.Participant); ok {
+			m.participants.SetItem(i, m.withRoles(p))
+		}
+	}
+}
+
+// rosterPanel pages through the channel's moderators or VIPs and adds
+// and removes them.
+type rosterPanel struct {
+	role channelRole
+	// cursors holds the cursor each loaded page starts after
+	cursors  []string
+	page     int
+	members  []services.ChannelMember
+	next     string
+	loaded   bool
+	err      error
+	selected int
+	adding   bool
+	// The member waiting to be confirmed for removal, or nil
+	removing *services.ChannelMember
+	input    textinput.Model
+}
+
+func newRosterPanel(m *ChatModel) (*rosterPanel, tea.Cmd) {
+	p := &rosterPanel{cursors: []string{""}, input: textinput.New()}
+	return p, p.load(m)
+}
+
+func (p *rosterPanel) load(m *ChatModel) tea.Cmd {
+	p.loaded = false
+	return m.loadRosterPageCmd(p.role, p.page, p.cursors[p.page])
+}
+
+func (p *rosterPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	if loaded, ok := msg.(RosterPageLoaded); ok {
+		if loaded.role != p.role || loaded.page != p.page {
+			return nil
+		}
+		p.members, p.next, p.err, p.loaded = loaded.members, loaded.next, loaded.err, true
+		return nil
+	}
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	if p.adding {
+		if key.String() == "enter" {
+			p.adding = false
+			p.input.Blur()
+			login := strings.TrimPrefix(strings.TrimSpace(p.input.Value()), "@")
+			if login == "" {
+				return nil
+			}
+			role := p.role
+			return m.withUser(login, func(user services.UserInfo) tea.Cmd {
+				return m.changeRoleCmd(role, true, user.ID)
+			})
+		}
+		var cmd tea.Cmd
+		p.input, cmd = p.input.Update(key)
+		return cmd
+	}
+	// Removal is confirmed here rather than in a prompt so the roster
+	// keeps its tab and page
+	if p.removing != nil {
+		switch key.String() {
+		case "y", "enter":
+			u := *p.removing
+			p.removing = nil
+			return m.changeRoleCmd(p.role, false, u.UserID)
+		case "n":
+			p.removing = nil
+		}
+		return nil
+	}
+
+	switch key.String() {
+	case "tab":
+		p.role = 1 - p.role
+		p.cursors, p.page, p.selected = []string{""}, 0, 0
+		p.members, p.next = nil, ""
+		return p.load(m)
+	case "up":
+		p.selected = max(0, p.selected-1)
+	case "down":
+		p.selected = max(0, min(len(p.members)-1, p.selected+1))
+	case "right", "n":
+		if p.next == "" || !p.loaded {
+			return nil
+		}
+		p.cursors = append(p.cursors[:p.page+1], p.next)
+		p.page++
+		p.selected = 0
+		return p.load(m)
+	case "left", "p":
+		if p.page == 0 {
+			return nil
+		}
+		p.page--
+		p.selected = 0
+		return p.load(m)
+	case "a":
+		p.adding = true
+		p.input.Placeholder = "login of the new " + p.role.String()
+		p.input.SetValue("")
+		return p.input.Focus()
+	case "x":
+		if len(p.members) > 0 {
+			u := p.members[min(p.selected, len(p.members)-1)]
+			p.removing = &u
+		}
+	}
+	return nil
+}
+
+func (p *rosterPanel) View(m *ChatModel, width, height int) string {
+	tabs := []string{"Moderators", "VIPs"}
+	tabs[p.role] = LabelStyle.Render("[" + tabs[p.role] + "]")
+	lines := []string{Header("Roster") + "  " + strings.Join(tabs, " ") + "  " + MutedStyle.Render(fmt.Sprintf("page %d", p.page+1)), ""}
+	if p.adding {
+		p.input.SetWidth(width)
+		lines = append(lines, p.input.View(), "")
+	}
+	if p.removing != nil {
+		lines = append(lines, LabelStyle.Render(fmt.Sprintf("Remove %s as a %s?", p.removing.UserName, p.role)), "")
+	}
+
+	switch {
+	case p.err != nil:
+		return strings.Join(append(lines, RenderError(p.err.Error())), "\n")
+	case !p.loaded:
+		return strings.Join(append(lines, MutedStyle.Render("Loading…")), "\n")
+	case len(p.members) == 0:
+		return strings.Join(append(lines, MutedStyle.Render(fmt.Sprintf("No %ss", p.role))), "\n")
+	}
+
+	// Scroll to keep the selected member in view
+	selected := min(p.selected, len(p.members)-1)
+	visible := max(1, height-len(lines))
+	start := max(0, selected-visible+1)
+	for i := start; i < len(p.members) && i < start+visible; i++ {
+		line := p.members[i].UserName
+		if !strings.EqualFold(p.members[i].UserName, p.members[i].UserLogin) {
+			line += " " + MutedStyle.Render("("+p.members[i].UserLogin+")")
+		}
+		if i == selected {
+			line = LabelStyle.Render("> ") + line
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *rosterPanel) Help() string {
+	if p.adding {
+		return "enter: add   esc: close"
+	}
+	if p.removing != nil {
+		return "y: remove   n: keep   esc: close"
+	}
+	return "tab: moderators/VIPs   n/p: next/previous page   a: add   x: remove   esc: close"
+}
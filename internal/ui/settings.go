@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// How long users must wait between messages when slow mode is toggled on, in seconds.
+const defaultSlowModeSeconds = 30
+
+// Twitch's limits on the slow mode wait, in seconds.
+const (
+	minSlowModeSeconds = 3
+	maxSlowModeSeconds = 120
+)
+
+// ChatSettingsLoaded carries the chat modes read from or written to the Twitch API.
+type ChatSettingsLoaded struct {
+	settings *services.ChatSettings
+	notice   string
+	err      error
+}
+
+func (m *ChatModel) loadChatSettingsCmd() tea.Cmd {
+	return func() tea.Msg {
+		settings, err := services.GetChatSettings(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser)
+		return ChatSettingsLoaded{settings: settings, err: err}
+	}
+}
+
+// updateChatSettingsCmd changes the chat modes present in settings.
+func (m *ChatModel) updateChatSettingsCmd(settings map[string]any) tea.Cmd {
+	return func() tea.Msg {
+		updated, err := services.UpdateChatSettings(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, settings)
+		return ChatSettingsLoaded{settings: updated, notice: "Chat settings updated", err: err}
+	}
+}
+
+// settingsPanel shows the chat's modes, kept current by
+// channel.chat_settings.update events, and lets the broadcaster toggle them.
+type settingsPanel struct{}
+
+func (p *settingsPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	s := m.chatSettings
+	if s == nil {
+		if key.String() == "r" && m.chatSettingsErr != nil {
+			m.chatSettingsErr = nil
+			return m.loadChatSettingsCmd()
+		}
+		return nil
+	}
+	switch key.String() {
+	case "1":
+		return m.updateChatSettingsCmd(map[string]any{"emote_mode": !s.EmoteMode})
+	case "2":
+		// Turning follower mode on without a duration lets any follower chat
+		return m.updateChatSettingsCmd(map[string]any{"follower_mode": !s.FollowerMode})
+	case "3":
+		if s.SlowMode {
+			return m.updateChatSettingsCmd(map[string]any{"slow_mode": false})
+		}
+		return m.updateChatSettingsCmd(map[string]any{
+			"slow_mode":           true,
+			"slow_mode_wait_time": defaultSlowModeSeconds,
+		})
+	case "4":
+		return m.updateChatSettingsCmd(map[string]any{"subscriber_mode": !s.SubscriberMode})
+	case "5":
+		return m.updateChatSettingsCmd(map[string]any{"unique_chat_mode": !s.UniqueChatMode})
+	}
+	return nil
+}
+
+func (p *settingsPanel) View(m *ChatModel, width, height int) string {
+	lines := []string{Header("Chat settings"), ""}
+	switch {
+	case m.chatSettingsErr != nil:
+		lines = append(lines, RenderError(m.chatSettingsErr.Error()), MutedStyle.Render("Press r to retry"))
+	case m.chatSettings == nil:
+		lines = append(lines, MutedStyle.Render("Loading…"))
+	default:
+		lines = append(lines, chatModeFields(m.chatSettings)...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func chatModeFields(s *services.ChatSettings) []string {
+	follower := onOff(s.FollowerMode)
+	if s.FollowerMode && s.FollowerModeDuration != nil && *s.FollowerModeDuration > 0 {
+		follower += fmt.Sprintf(" (followed for %dm)", *s.FollowerModeDuration)
+	}
+	slow := onOff(s.SlowMode)
+	if s.SlowMode && s.SlowModeWaitTime != nil {
+		slow += fmt.Sprintf(" (%ds)", *s.SlowModeWaitTime)
+	}
+
+	return []string{
+		Field("1 Emote-only", onOff(s.EmoteMode)),
+		Field("2 Follower-only", follower),
+		Field("3 Slow", slow),
+		Field("4 Subscriber-only", onOff(s.SubscriberMode)),
+		Field("5 Unique chat", onOff(s.UniqueChatMode)),
+	}
+}
+
+func (p *settingsPanel) Help() string {
+	return "1-5: toggle mode   esc: close"
+}
+
+func onOff(on bool) string {
+	if on {
+		return "on"
+	}
+	return MutedStyle.Render("off")
+}
@@ -0,0 +1,47 @@
+package ui
+
+import (
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// ShieldModeLoaded carries the shield mode status read from or written to
+// the Twitch API.
+type ShieldModeLoaded struct {
+	status *services.ShieldModeStatus
+	err    error
+}
+
+func (m *ChatModel) loadShieldModeCmd() tea.Cmd {
+	return func() tea.Msg {
+		status, err := services.GetShieldModeStatus(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser)
+		return ShieldModeLoaded{status: status, err: err}
+	}
+}
+
+// toggleShieldModeCmd turns shield mode off if it is on, and on otherwise.
+func (m *ChatModel) toggleShieldModeCmd() tea.Cmd {
+	active := !m.shieldMode
+	return func() tea.Msg {
+		status, err := services.UpdateShieldModeStatus(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, active)
+		return ShieldModeLoaded{status: status, err: err}
+	}
+}
+
+func (m *ChatModel) setShieldMode(msg ShieldModeLoaded) {
+	if msg.err != nil {
+		m.addNotice(RenderError(msg.err.Error()))
+		return
+	}
+	m.shieldMode = msg.status.IsActive
+}
+
+// shieldModeChanged applies a channel.shield_mode.begin or end event.
+func (m *ChatModel) shieldModeChanged(active bool, event services.ShieldModeEvent) {
+	m.shieldMode = active
+	if active {
+		m.addNotice(Notice(event.ModeratorUserName + " turned on shield mode"))
+	} else {
+		m.addNotice(Notice(event.ModeratorUserName + " turned off shield mode"))
+	}
+}
@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// ClockTick redraws the parts of the chat that change with time, such as
+// the stream uptime.
+type ClockTick struct{}
+
+func clockTickCmd() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg { return ClockTick{} })
+}
+
+// streamState is what the header above the chat shows about the stream.
+type streamState struct {
+	live      bool
+	startedAt time.Time
+	title     string
+	category  string
+	hypeTrain *hypeTrain // nil when no hype train is running
+	// When the running ad break ends and the next ad is due, zero if unknown
+	adBreakEndsAt time.Time
+	nextAdAt      time.Time
+}
+
+// StreamLoaded carries the stream and channel information read at startup.
+type StreamLoaded struct {
+	stream  *services.Stream
+	channel *services.ChannelInfo
+	err     error
+}
+
+func (m *ChatModel) loadStreamCmd() tea.Cmd {
+	return func() tea.Msg {
+		stream, err := services.GetStream(m.httpClient, m.accessToken, m.loggedInUser)
+		if err != nil {
+			return StreamLoaded{err: err}
+		}
+		channel, err := services.GetChannelInformation(m.httpClient, m.accessToken, m.loggedInUser)
+		return StreamLoaded{stream: stream, channel: channel, err: err}
+	}
+}
+
+func (m *ChatModel) setStream(msg StreamLoaded) {
+	if msg.stream != nil {
+		m.stream.live = true
+		m.stream.startedAt = msg.stream.StartedAt
+	}
+	if msg.channel != nil {
+		m.stream.title = msg.channel.Title
+		m.stream.category = msg.channel.GameName
+	}
+}
+
+func (m *ChatModel) streamOnline(event services.StreamOnlineEvent) {
+	m.stream.live = true
+	m.stream.startedAt = event.StartedAt
+	m.addNotice(Notice("The stream is now live"))
+}
+
+// loadAdScheduleIfLive loads the ad schedule, which only exists while live.
+func (m *ChatModel) loadAdScheduleIfLive() tea.Cmd {
+	if !m.stream.live {
+		return nil
+	}
+	return m.loadAdScheduleCmd()
+}
+
+func (m *ChatModel) streamOffline() {
+	notice := "The stream is now offline"
+	if m.stream.live && !m.stream.startedAt.IsZero() {
+		notice += " after " + formatUptime(time.Since(m.stream.startedAt))
+	}
+	m.stream.live = false
+	m.stream.startedAt = time.Time{}
+	m.stream.nextAdAt = time.Time{}
+	m.addNotice(Notice(notice))
+}
+
+func (m *ChatModel) channelUpdated(event services.ChannelUpdateEvent) {
+	if event.Title != m.stream.title {
+		m.addNotice(Notice("Title changed to " + event.Title))
+	}
+	if event.CategoryName != m.stream.category {
+		m.addNotice(Notice("Category changed to " + orNone(event.CategoryName)))
+	}
+	m.stream.title = event.Title
+	m.stream.category = event.CategoryName
+}
+
+// streamHeader renders the live state, uptime, shield mode, ads, hype
+// train, title and category on one line.
+func (m *ChatModel) streamHeader() string {
+	state := OfflineStyle.Render("○ OFFLINE")
+	if m.stream.live {
+		state = LiveStyle.Render("● LIVE")
+		if !m.stream.startedAt.IsZero() {
+			state += " " + formatUptime(time.Since(m.stream.startedAt))
+		}
+	}
+
+	parts := []string{state}
+	if m.shieldMode {
+		parts = append(parts, ShieldStyle.Render("SHIELD MODE"))
+	}
+	if ad := m.adHeader(); ad != "" {
+		parts = append(parts, ad)
+	}
+	if m.stream.hypeTrain != nil {
+		parts = append(parts, m.stream.hypeTrain.header())
+	}
+	if m.stream.title != "" {
+		parts = append(parts, m.stream.title)
+	}
+	if m.stream.category != "" {
+		parts = append(parts, MutedStyle.Render(m.stream.category))
+	}
+	width := m.Width - StreamHeaderStyle.GetHorizontalMargins()
+	return StreamHeaderStyle.Width(width).MaxHeight(1).Render(strings.Join(parts, MutedStyle.Render(" │ ")))
+}
+
+// formatUptime renders d as hours, minutes and seconds, e.g. "1h02m03s".
+func formatUptime(d time.Duration) string {
+	d = d.Truncate(time.Second)
+	h, m, s := int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60
+	if h > 0 {
+		return fmt.Sprintf("%dh%02dm%02ds", h, m, s)
+	}
+	return fmt.Sprintf("%dm%02ds", m, s)
+}
@@ -0,0 +1,177 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// Low trust statuses a moderator can give a suspicious user
+const (
+	lowTrustNone       = "none"
+	lowTrustMonitored  = "active_monitoring"
+	lowTrustRestricted = "restricted"
+)
+
+// warning is the last warning a user was sent.
+type warning struct {
+	reason       string
+	moderator    string
+	at           time.Time
+	acknowledged bool
+}
+
+func init() {
+	registerCommand(command{
+		name:  "warn",
+		usage: "/warn <user> <reason>",
+		help:  "Warn a user, who must acknowledge it before chatting again",
+		run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+			if len(args) < 2 {
+				return nil, errUsage
+			}
+			reason := strings.Join(args[1:], " ")
+			return m.withUser(args[0], func(user services.UserInfo) tea.Cmd {
+				return m.warnCmd(user.ID, reason)
+			}), nil
+		},
+	})
+}
+
+// suspiciousMarker renders the marker shown before a suspicious user's messages.
+func suspiciousMarker(status string) string {
+	switch status {
+	case lowTrustMonitored:
+		return MonitoredStyle.Render("[monitored]")
+	case lowTrustRestricted:
+		return RestrictedStyle.Render("[restricted]")
+	}
+	return ""
+}
+
+func lowTrustLabel(status string) string {
+	switch status {
+	case lowTrustMonitored:
+		return "monitored"
+	case lowTrustRestricted:
+		return "restricted"
+	}
+	return "none"
+}
+
+func (m *ChatModel) setLowTrust(userID, status string) {
+	if status == "" || status == lowTrustNone {
+		delete(m.lowTrust, userID)
+		return
+	}
+	m.lowTrust[userID] = status
+}
+
+// suspiciousMessage marks a message from a monitored or restricted user.
+// Messages from restricted users are only sent to moderators, so they are
+// added to the chat when they have not been seen.
+func (m *ChatModel) suspiciousMessage(event services.SuspiciousUserMessageEvent) {
+	m.setLowTrust(event.UserID, event.LowTrustStatus)
+	marker := suspiciousMarker(event.LowTrustStatus)
+	if marker == "" {
+		return
+	}
+
+	for i := len(m.history) - 1; i >= 0; i-- {
+		line := m.history[i]
+		if line.messageID != event.Message.MessageID {
+			continue
+		}
+		if line.lowTrust == "" {
+			m.history[i].lowTrust = event.LowTrustStatus
+			m.history[i].rendered = marker + " " + line.rendered
+			m.chat.SetMessage(i, m.history[i].rendered)
+		}
+		return
+	}
+
+	if event.LowTrustStatus != lowTrustRestricted {
+		// The channel.chat.message notification follows and is marked then
+		return
+	}
+	m.appendLine(chatLine{
+		messageID: event.Message.MessageID,
+		userID:    event.UserID,
+		login:     event.UserLogin,
+		name:      event.UserName,
+		text:      event.Message.Text,
+		sentAt:    time.Now(),
+		lowTrust:  event.LowTrustStatus,
+	}, marker+" "+event.UserName+": "+renderFragments(event.Message.Fragments, event.Message.Text))
+}
+
+// hasMessage reports whether the chat already shows the message.
+func (m *ChatModel) hasMessage(messageID string) bool {
+	if messageID == "" {
+		return false
+	}
+	for i := len(m.history) - 1; i >= 0; i-- {
+		if m.history[i].messageID == messageID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *ChatModel) suspiciousUserUpdated(event services.SuspiciousUserUpdateEvent) {
+	m.setLowTrust(event.UserID, event.LowTrustStatus)
+	m.addNotice(NoticeStyle.Render(fmt.Sprintf("%s set %s's low trust status to %s",
+		event.ModeratorUserName, event.UserName, lowTrustLabel(event.LowTrustStatus))))
+}
+
+func (m *ChatModel) warningSent(event services.WarningSendEvent) {
+	m.warnings[event.UserID] = &warning{
+		reason:    event.Reason,
+		moderator: event.ModeratorUserName,
+		at:        time.Now(),
+	}
+	m.addNotice(WarningStyle.Render(" WARNING ") + " " +
+		NoticeStyle.Render(fmt.Sprintf("%s warned %s%s", event.ModeratorUserName, event.UserName, withReason(event.Reason))))
+}
+
+func (m *ChatModel) warningAcknowledged(event services.WarningAcknowledgeEvent) {
+	if w := m.warnings[event.UserID]; w != nil {
+		w.acknowledged = true
+	}
+	m.addNotice(NoticeStyle.Render(event.UserName + " acknowledged their warning"))
+}
+
+// warningStatus describes the last warning the user was sent this session.
+func (m *ChatModel) warningStatus(userID string) string {
+	w := m.warnings[userID]
+	if w == nil {
+		return "none"
+	}
+	status := fmt.Sprintf("%s by %s, %s ago", w.reason, w.moderator, humanizeDuration(time.Since(w.at)))
+	if !w.acknowledged {
+		status += " " + MutedStyle.Render("(not acknowledged)")
+	}
+	return status
+}
+
+func (m *ChatModel) promptWarn(userID, name string) tea.Cmd {
+	return m.openOverlay(newInputPrompt(fmt.Sprintf("Warn %s?", name), "Reason", func(m *ChatModel, reason string) tea.Cmd {
+		return m.warnCmd(userID, strings.TrimSpace(reason))
+	}))
+}
+
+// warnCmd warns the user. The channel.warning.send event reports the
+// warning in chat.
+func (m *ChatModel) warnCmd(userID, reason string) tea.Cmd {
+	return func() tea.Msg {
+		if reason == "" {
+			return ActionDone{err: errors.New("a warning needs a reason")}
+		}
+		err := services.WarnChatUser(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, userID, reason)
+		return ActionDone{err: err}
+	}
+}
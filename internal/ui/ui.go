@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"strings"
+
 	"charm.land/lipgloss/v2"
 )
 
@@ -12,6 +14,26 @@ var (
 	ChatBoxStyle           = lipgloss.NewStyle().Border(lipgloss.NormalBorder()).BorderForeground(lipgloss.Magenta).Padding(0, 1).Margin(0, 1)
 	ChatInputStyle         = lipgloss.NewStyle().Border(lipgloss.NormalBorder()).BorderForeground(lipgloss.Magenta).Padding(0, 1).Margin(0, 1) // Accent border
 	ChatInputDisabledStyle = ChatInputStyle.BorderForeground(lipgloss.Color("#AAAAAA"))
+	OverlayStyle           = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Magenta).Padding(0, 1).Margin(0, 1)
+	NoticeStyle            = lipgloss.NewStyle().Foreground(lipgloss.Color("#AAAAAA")).Italic(true)
+	LabelStyle             = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Magenta)
+	MutedStyle             = lipgloss.NewStyle().Foreground(lipgloss.Color("#AAAAAA"))
+	StreamHeaderStyle      = lipgloss.NewStyle().Margin(0, 1).Padding(0, 1).Background(lipgloss.Color("#262626"))
+	LiveStyle              = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Red)
+	OfflineStyle           = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#AAAAAA"))
+	BadgeStyle             = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.White).Background(lipgloss.Red).Padding(0, 1)
+	AlertStyle             = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.White).Background(lipgloss.Color("#6441A5"))
+	CheerStyle             = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#9146FF"))
+	ShoutoutStyle          = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#FFB31A"))
+	ProgressBarStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("#9146FF"))
+	AdStyle                = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#262626")).Background(lipgloss.Color("#FFB31A")).Padding(0, 1)
+	DeletedStyle           = MutedStyle.Strikethrough(true)
+	ShieldStyle            = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.White).Background(lipgloss.Color("#1F69FF")).Padding(0, 1)
+	HypeTrainStyle         = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#BF94FF"))
+	MonitoredStyle         = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#FFB31A"))
+	RestrictedStyle        = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Red)
+	EmoteStyle             = lipgloss.NewStyle().Foreground(lipgloss.Color("#00C8AF"))
+	WarningStyle           = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#262626")).Background(lipgloss.Color("#FFB31A"))
 )
 
 func RenderError(msg string) string {
@@ -25,3 +47,18 @@ func Header(text string) string {
 func AppTitle(text string, width int) string {
 	return TitleStyle.Width(width).Render(text)
 }
+
+func Notice(text string) string {
+	return NoticeStyle.Render("* " + text)
+}
+
+// Field renders a "label: value" line for detail panels.
+func Field(label, value string) string {
+	return LabelStyle.Render(label+":") + " " + value
+}
+
+// ProgressBar renders a horizontal bar filled to percent of width.
+func ProgressBar(percent, width int) string {
+	filled := width * min(100, max(0, percent)) / 100
+	return ProgressBarStyle.Render(strings.Repeat("█", filled)) + MutedStyle.Render(strings.Repeat("░", width-filled))
+}
@@ -0,0 +1,239 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// How many of the user's messages the unban request queue shows.
+const unbanRequestHistorySize = 10
+
+// UnbanRequestsLoaded carries the channel's pending unban requests.
+type UnbanRequestsLoaded struct {
+	requests []services.UnbanRequest
+	err      error
+}
+
+// UnbanRequestResolved reports the outcome of approving or denying an
+// unban request.
+type UnbanRequestResolved struct {
+	request *services.UnbanRequest
+	err     error
+}
+
+func (m *ChatModel) loadUnbanRequestsCmd() tea.Cmd {
+	return func() tea.Msg {
+		var requests []services.UnbanRequest
+		cursor := ""
+		for {
+			page, next, err := services.GetUnbanRequests(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, "pending", cursor)
+			if err != nil {
+				return UnbanRequestsLoaded{err: err}
+			}
+			requests = append(requests, page...)
+			if next == "" {
+				return UnbanRequestsLoaded{requests: requests}
+			}
+			cursor = next
+		}
+	}
+}
+
+func (m *ChatModel) resolveUnbanRequestCmd(request services.UnbanRequest, status, resolution string) tea.Cmd {
+	return func() tea.Msg {
+		resolved, err := services.ResolveUnbanRequest(m.httpClient, m.accessToken, m.loggedInUser, m.loggedInUser, request.ID, status, resolution)
+		return UnbanRequestResolved{request: resolved, err: err}
+	}
+}
+
+func (m *ChatModel) addUnbanRequest(event services.UnbanRequestCreateEvent) {
+	m.unbanRequests = append(m.unbanRequests, services.UnbanRequest{
+		ID:        event.ID,
+		UserID:    event.UserID,
+		UserLogin: event.UserLogin,
+		UserName:  event.UserName,
+		Text:      event.Text,
+		Status:    "pending",
+		CreatedAt: event.CreatedAt,
+	})
+	m.addNotice(Notice(event.UserName + " requested to be unbanned"))
+}
+
+// unbanRequestResolved removes an unban request that was approved, denied
+// or canceled, announcing it unless it was resolved from this chat.
+func (m *ChatModel) unbanRequestResolved(event services.UnbanRequestResolveEvent) {
+	if !m.removeUnbanRequest(event.ID) {
+		return
+	}
+	if event.ModeratorUserName == "" {
+		m.addNotice(Notice(event.UserName + " canceled their unban request"))
+		return
+	}
+	m.addNotice(Notice(fmt.Sprintf("%s %s the unban request from %s", event.ModeratorUserName, event.Status, event.UserName)))
+}
+
+func (m *ChatModel) removeUnbanRequest(id string) bool {
+	for i, r := range m.unbanRequests {
+		if r.ID == id {
+			m.unbanRequests = append(m.unbanRequests[:i], m.unbanRequests[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// userHistory renders what the chat has seen of a user this session: their
+// moderation log entries and most recent messages, oldest first.
+func (m *ChatModel) userHistory(userID string) []string {
+	var lines []string
+	for _, e := range m.modLog {
+		if e.userID == userID {
+			lines = append(lines, MutedStyle.Render(e.at.Format("15:04"))+" "+LabelStyle.Render(e.moderator)+" "+e.text)
+		}
+	}
+	for _, line := range m.messagesFrom(userID, unbanRequestHistorySize) {
+		lines = append(lines, MutedStyle.Render(line.sentAt.Format("15:04"))+" "+line.text)
+	}
+	return lines
+}
+
+// unbanRequestsPanel is the queue of pending unban requests, with the
+// selected user's history from this session.
+type unbanRequestsPanel struct {
+	selected int
+	loaded   bool
+	err      error
+	// The status the resolution text is being written for, empty when
+	// not resolving, and the request it resolves
+	resolving string
+	request   services.UnbanRequest
+	input     textinput.Model
+}
+
+func newUnbanRequestsPanel(m *ChatModel) (*unbanRequestsPanel, tea.Cmd) {
+	return &unbanRequestsPanel{input: textinput.New()}, m.loadUnbanRequestsCmd()
+}
+
+func (p *unbanRequestsPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	if loaded, ok := msg.(UnbanRequestsLoaded); ok {
+		p.loaded, p.err = true, loaded.err
+		if loaded.err == nil {
+			m.unbanRequests = loaded.requests
+		}
+		return nil
+	}
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+
+	// The queue can change while the message is typed, so resolve the
+	// request that was selected when y or n was pressed
+	if p.resolving != "" {
+		if key.String() == "enter" {
+			status := p.resolving
+			p.resolving = ""
+			p.input.Blur()
+			return m.resolveUnbanRequestCmd(p.request, status, strings.TrimSpace(p.input.Value()))
+		}
+		var cmd tea.Cmd
+		p.input, cmd = p.input.Update(key)
+		return cmd
+	}
+
+	if len(m.unbanRequests) == 0 {
+		return nil
+	}
+	selected := min(p.selected, len(m.unbanRequests)-1)
+
+	switch key.String() {
+	case "up":
+		p.selected = max(0, selected-1)
+	case "down":
+		p.selected = min(len(m.unbanRequests)-1, selected+1)
+	case "y", "n":
+		p.resolving = "approved"
+		if key.String() == "n" {
+			p.resolving = "denied"
+		}
+		p.request = m.unbanRequests[selected]
+		p.input.Placeholder = "message to " + p.request.UserName + " (optional)"
+		p.input.SetValue("")
+		return p.input.Focus()
+	}
+	return nil
+}
+
+func (p *unbanRequestsPanel) View(m *ChatModel, width, height int) string {
+	title := Header(fmt.Sprintf("Unban requests (%d pending)", len(m.unbanRequests)))
+	// The request being resolved stays on screen even once it has left
+	// the queue, as enter still resolves it
+	if p.resolving == "" {
+		switch {
+		case p.err != nil:
+			return title + "\n\n" + RenderError(p.err.Error())
+		case !p.loaded && len(m.unbanRequests) == 0:
+			return title + "\n\n" + MutedStyle.Render("Loading…")
+		case len(m.unbanRequests) == 0:
+			return title + "\n\n" + MutedStyle.Render("No pending unban requests")
+		}
+	}
+
+	selected := min(p.selected, len(m.unbanRequests)-1)
+	listWidth := min(24, width/3)
+	list := []string{title, ""}
+	for i, r := range m.unbanRequests {
+		if i == selected {
+			list = append(list, LabelStyle.Render("> ")+r.UserName)
+		} else {
+			list = append(list, "  "+r.UserName)
+		}
+	}
+
+	r := p.request
+	if p.resolving == "" {
+		r = m.unbanRequests[selected]
+	}
+	details := []string{
+		Header(r.UserName),
+		"",
+		Field("Requested", r.CreatedAt.Local().Format("Jan 2 15:04")),
+		Field("Message", r.Text),
+		"",
+	}
+	if p.resolving != "" {
+		p.input.SetWidth(width - listWidth)
+		details = append(details, LabelStyle.Render(resolveVerb(p.resolving)+":"), p.input.View(), "")
+	}
+	details = append(details, Header("This session"))
+	history := m.userHistory(r.UserID)
+	if len(history) == 0 {
+		history = []string{MutedStyle.Render("Nothing seen from this user yet")}
+	}
+	details = append(details, history...)
+
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().Width(listWidth).Render(strings.Join(list, "\n")),
+		lipgloss.NewStyle().Width(width-listWidth).Render(strings.Join(details, "\n")),
+	)
+}
+
+func (p *unbanRequestsPanel) Help() string {
+	if p.resolving != "" {
+		return "enter: " + resolveVerb(p.resolving) + "   esc: close"
+	}
+	return "up/down: select   y: approve   n: deny   esc: close"
+}
+
+// resolveVerb names the action that gives an unban request a status.
+func resolveVerb(status string) string {
+	if status == "denied" {
+		return "deny"
+	}
+	return "approve"
+}
@@ -0,0 +1,222 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// How many of the user's messages the user card shows.
+const userCardRecentMessages = 5
+
+// userCard shows who a chatter is, their relationship to the channel and
+// what they have said this session, with quick actions on them.
+type userCard struct {
+	userID string
+	login  string
+	name   string
+
+	loading  bool
+	user     *services.UserInfo
+	userErr  error
+	follower *services.Follower
+	// followErr is set when the follow status could not be read,
+	// e.g. because the token lacks the required scope.
+	followErr error
+	sub       *services.Subscription
+	subErr    error
+}
+
+type userCardLoaded struct {
+	userID    string
+	user      *services.UserInfo
+	userErr   error
+	follower  *services.Follower
+	followErr error
+	sub       *services.Subscription
+	subErr    error
+}
+
+func newUserCard(m *ChatModel, userID, login, name string) (*userCard, tea.Cmd) {
+	card := &userCard{userID: userID, login: login, name: name, loading: true}
+	httpClient, accessToken, broadcasterID := m.httpClient, m.accessToken, m.loggedInUser
+
+	return card, func() tea.Msg {
+		loaded := userCardLoaded{userID: userID}
+		users, err := services.GetUsers(httpClient, accessToken, userID)
+		if err == nil && len(users) == 0 {
+			err = fmt.Errorf("user %s not found", userID)
+		}
+		if err != nil {
+			loaded.userErr = err
+		} else {
+			loaded.user = &users[0]
+		}
+		loaded.follower, loaded.followErr = services.GetChannelFollower(httpClient, accessToken, broadcasterID, userID)
+		loaded.sub, loaded.subErr = services.GetBroadcasterSubscription(httpClient, accessToken, broadcasterID, userID)
+		return loaded
+	}
+}
+
+func (c *userCard) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case userCardLoaded:
+		if msg.userID != c.userID {
+			return nil
+		}
+		c.loading = false
+		c.user, c.userErr = msg.user, msg.userErr
+		c.follower, c.followErr = msg.follower, msg.followErr
+		c.sub, c.subErr = msg.sub, msg.subErr
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "m":
+			m.closeOverlay()
+			return m.startInput("@" + c.login + " ")
+		case "r":
+			line, ok := m.lastMessageFrom(c.userID)
+			if !ok {
+				return nil
+			}
+			m.closeOverlay()
+			m.replyTo = &line
+			return m.startInput("")
+		case "t":
+			if !c.moderatable(m) {
+				return nil
+			}
+			return m.promptBan(c.userID, c.name, defaultTimeoutSeconds)
+		case "b":
+			if !c.moderatable(m) {
+				return nil
+			}
+			return m.promptBan(c.userID, c.name, 0)
+		case "n":
+			if !c.moderatable(m) {
+				return nil
+			}
+			return m.promptUnban(c.userID, c.name)
+		case "o":
+			if !c.moderatable(m) {
+				return nil
+			}
+			m.closeOverlay()
+			return m.shoutoutCmd(c.userID)
+		case "w":
+			if !c.moderatable(m) {
+				return nil
+			}
+			return m.promptWarn(c.userID, c.name)
+		}
+	}
+	return nil
+}
+
+// moderatable reports whether the logged-in user may moderate this user.
+// Chat always happens in the logged-in user's own channel, so they are the
+// broadcaster and may moderate anyone but themselves.
+func (c *userCard) moderatable(m *ChatModel) bool {
+	return c.userID != m.loggedInUser
+}
+
+func (c *userCard) View(m *ChatModel, width, height int) string {
+	lines := []string{Header(fmt.Sprintf("%s (%s)", c.name, c.login)), ""}
+
+	switch {
+	case c.loading:
+		lines = append(lines, MutedStyle.Render("Loading…"))
+	case c.userErr != nil:
+		lines = append(lines, RenderError(c.userErr.Error()))
+	default:
+		lines = append(lines,
+			Field("Created", formatCreatedAt(c.user.CreatedAt)),
+			Field("Broadcaster type", orNone(c.user.BroadcasterType)),
+			Field("Following", c.followStatus()),
+			Field("Subscription", c.subStatus()),
+			Field("Low trust", lowTrustLabel(m.lowTrust[c.userID])),
+			Field("Warning", m.warningStatus(c.userID)),
+		)
+		if c.user.Description != "" {
+			lines = append(lines, "", lipgloss.NewStyle().Width(width).Render(c.user.Description))
+		}
+	}
+
+	lines = append(lines, "", LabelStyle.Render("Recent messages"))
+	recent := m.messagesFrom(c.userID, userCardRecentMessages)
+	if len(recent) == 0 {
+		lines = append(lines, MutedStyle.Render("No messages this session"))
+	}
+	for _, line := range recent {
+		lines = append(lines, MutedStyle.Render(line.sentAt.Format("15:04"))+" "+line.text)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (c *userCard) Help() string {
+	return "m: mention   r: reply   t: timeout   b: ban   n: unban   o: shoutout   w: warn   esc: close"
+}
+
+func (c *userCard) followStatus() string {
+	switch {
+	case c.followErr != nil:
+		return MutedStyle.Render("unknown")
+	case c.follower == nil:
+		return "Not following"
+	}
+	return fmt.Sprintf("%s (since %s)", humanizeDuration(time.Since(c.follower.FollowedAt)), c.follower.FollowedAt.Format("2006-01-02"))
+}
+
+func (c *userCard) subStatus() string {
+	switch {
+	case c.subErr != nil:
+		return MutedStyle.Render("unknown")
+	case c.sub == nil:
+		return "Not subscribed"
+	}
+	status := formatTier(c.sub.Tier)
+	if c.sub.IsGift {
+		status += " (gift from " + c.sub.GifterName + ")"
+	}
+	return status
+}
+
+func formatCreatedAt(createdAt string) string {
+	t, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return createdAt
+	}
+	return fmt.Sprintf("%s (%s ago)", t.Format("2006-01-02"), humanizeDuration(time.Since(t)))
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+// humanizeDuration renders d in its largest sensible units, e.g. "2y 3mo" or "5d".
+func humanizeDuration(d time.Duration) string {
+	const day = 24 * time.Hour
+	days := int(d / day)
+	switch {
+	case days >= 365:
+		years, months := days/365, (days%365)/30
+		if months == 0 {
+			return fmt.Sprintf("%dy", years)
+		}
+		return fmt.Sprintf("%dy %dmo", years, months)
+	case days >= 30:
+		return fmt.Sprintf("%dmo", days/30)
+	case days >= 1:
+		return fmt.Sprintf("%dd", days)
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	}
+	return fmt.Sprintf("%dm", int(d/time.Minute))
+}
@@ -0,0 +1,202 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/WilliamJohnathonLea/tui-chat/internal/services"
+)
+
+// conversation is the whispers exchanged with one other user.
+type conversation struct {
+	partnerID    string
+	partnerLogin string
+	partnerName  string
+	messages     []whisper
+	unread       int
+}
+
+type whisper struct {
+	fromSelf bool
+	text     string
+	sentAt   time.Time
+}
+
+// WhisperSent reports the outcome of sending a whisper.
+type WhisperSent struct {
+	to   services.UserInfo
+	text string
+	err  error
+}
+
+func init() {
+	for _, name := range []string{"w", "whisper"} {
+		registerCommand(command{
+			name:  name,
+			usage: "/" + name + " <user> <message>",
+			help:  "Send a private message",
+			run: func(m *ChatModel, args []string) (tea.Cmd, error) {
+				if len(args) < 2 {
+					return nil, errUsage
+				}
+				text := strings.Join(args[1:], " ")
+				return m.withUser(args[0], func(user services.UserInfo) tea.Cmd {
+					return func() tea.Msg {
+						err := services.SendWhisper(m.httpClient, m.accessToken, m.loggedInUser, user.ID, text)
+						return WhisperSent{to: user, text: text, err: err}
+					}
+				}), nil
+			},
+		})
+	}
+}
+
+// conversationWith returns the conversation with a user, starting one if
+// needed, and moves it to the top of the list.
+func (m *ChatModel) conversationWith(userID, login, name string) *conversation {
+	for i, c := range m.whispers {
+		if c.partnerID == userID {
+			m.whispers = append(append([]*conversation{c}, m.whispers[:i]...), m.whispers[i+1:]...)
+			return c
+		}
+	}
+	c := &conversation{partnerID: userID, partnerLogin: login, partnerName: name}
+	m.whispers = append([]*conversation{c}, m.whispers...)
+	return c
+}
+
+func (m *ChatModel) addWhisper(event services.WhisperMessageEvent) {
+	c := m.conversationWith(event.FromUserID, event.FromUserLogin, event.FromUserName)
+	c.messages = append(c.messages, whisper{text: event.Whisper.Text, sentAt: time.Now()})
+	if p, ok := m.overlay.(*whispersPanel); ok {
+		if p.selectedID == "" {
+			// The first conversation stays on screen as others arrive
+			p.selectedID = c.partnerID
+		}
+		if p.selectedConversation(m) == c {
+			// The conversation is on screen, so it is read as it arrives
+			return
+		}
+	}
+	c.unread++
+}
+
+func (m *ChatModel) addSentWhisper(msg WhisperSent) {
+	c := m.conversationWith(msg.to.ID, msg.to.Login, msg.to.DisplayName)
+	c.messages = append(c.messages, whisper{fromSelf: true, text: msg.text, sentAt: time.Now()})
+}
+
+// unreadWhispers counts the whispers not yet seen in the whispers pane.
+func (m *ChatModel) unreadWhispers() int {
+	unread := 0
+	for _, c := range m.whispers {
+		unread += c.unread
+	}
+	return unread
+}
+
+// whispersPanel lists whisper conversations by partner alongside the
+// messages of the selected one.
+type whispersPanel struct {
+	// The partner whose conversation is shown, empty until there is one
+	selectedID string
+}
+
+func newWhispersPanel(m *ChatModel) (*whispersPanel, tea.Cmd) {
+	p := &whispersPanel{}
+	if len(m.whispers) > 0 {
+		p.selectedID = m.whispers[0].partnerID
+	}
+	p.markRead(m)
+	return p, nil
+}
+
+func (p *whispersPanel) Update(m *ChatModel, msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || len(m.whispers) == 0 {
+		return nil
+	}
+	selected := p.selectedIndex(m)
+	switch key.String() {
+	case "up":
+		p.selectedID = m.whispers[max(0, selected-1)].partnerID
+	case "down":
+		p.selectedID = m.whispers[min(len(m.whispers)-1, selected+1)].partnerID
+	case "r", "enter":
+		c := m.whispers[selected]
+		m.closeOverlay()
+		return m.startInput("/w " + c.partnerLogin + " ")
+	}
+	p.markRead(m)
+	return nil
+}
+
+func (p *whispersPanel) selectedIndex(m *ChatModel) int {
+	for i, c := range m.whispers {
+		if c.partnerID == p.selectedID {
+			return i
+		}
+	}
+	return 0
+}
+
+func (p *whispersPanel) selectedConversation(m *ChatModel) *conversation {
+	if len(m.whispers) == 0 {
+		return nil
+	}
+	return m.whispers[p.selectedIndex(m)]
+}
+
+func (p *whispersPanel) markRead(m *ChatModel) {
+	if c := p.selectedConversation(m); c != nil {
+		c.unread = 0
+	}
+}
+
+func (p *whispersPanel) View(m *ChatModel, width, height int) string {
+	if len(m.whispers) == 0 {
+		return Header("Whispers") + "\n\n" + MutedStyle.Render("No whispers yet. Send one with /w <user> <message>")
+	}
+	selected := p.selectedIndex(m)
+	partnerWidth := min(24, width/3)
+	partners := []string{Header("Whispers"), ""}
+	for i, c := range m.whispers {
+		line := c.partnerName
+		if c.unread > 0 {
+			line += " " + BadgeStyle.Render(fmt.Sprintf("%d", c.unread))
+		}
+		if i == selected {
+			line = LabelStyle.Render("> ") + line
+		} else {
+			line = "  " + line
+		}
+		partners = append(partners, line)
+	}
+
+	c := m.whispers[selected]
+	messages := []string{Header(c.partnerName), ""}
+	for _, w := range c.messages {
+		from := c.partnerName
+		if w.fromSelf {
+			from = "You"
+		}
+		messages = append(messages, MutedStyle.Render(w.sentAt.Format("15:04"))+" "+LabelStyle.Render(from+":")+" "+w.text)
+	}
+	// Show the most recent messages that fit
+	if len(messages) > height {
+		keep := max(0, height-2)
+		messages = append(messages[:2], messages[len(messages)-keep:]...)
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().Width(partnerWidth).Render(strings.Join(partners, "\n")),
+		lipgloss.NewStyle().Width(width-partnerWidth).Render(strings.Join(messages, "\n")),
+	)
+}
+
+func (p *whispersPanel) Help() string {
+	return "up/down: conversation   r: reply   esc: close"
+}